@@ -2,61 +2,325 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"flag"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/WuPinYi/SocialForge/internal/apikey"
+	"github.com/WuPinYi/SocialForge/internal/audit"
 	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/config"
+	"github.com/WuPinYi/SocialForge/internal/connect"
 	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/gateway"
+	"github.com/WuPinYi/SocialForge/internal/health"
+	"github.com/WuPinYi/SocialForge/internal/idempotency"
+	"github.com/WuPinYi/SocialForge/internal/metrics"
+	"github.com/WuPinYi/SocialForge/internal/postevents"
+	"github.com/WuPinYi/SocialForge/internal/provision"
+	"github.com/WuPinYi/SocialForge/internal/ratelimit"
+	"github.com/WuPinYi/SocialForge/internal/requestinfo"
 	"github.com/WuPinYi/SocialForge/internal/server"
+	"github.com/WuPinYi/SocialForge/internal/softdelete"
+	"github.com/WuPinYi/SocialForge/internal/tlsconfig"
+	"github.com/WuPinYi/SocialForge/internal/tracing"
+	"github.com/WuPinYi/SocialForge/internal/validation"
+	"github.com/WuPinYi/SocialForge/internal/vault"
 	"github.com/WuPinYi/SocialForge/internal/worker"
 	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
 	_ "github.com/lib/pq"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
 	"google.golang.org/grpc"
+	grpccredentials "google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
 func main() {
+	// Dispatch maintenance subcommands
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "vault":
+			runVault(os.Args[2:])
+			return
+		case "migrate":
+			runMigrate(os.Args[2:])
+			return
+		}
+	}
+
+	// Load the configuration from the flags, the environment and the
+	// config file
+	fs := flag.NewFlagSet("server", flag.ExitOnError)
+	printConfig := fs.Bool("print-config", false, "print the configuration with secrets redacted and exit")
+	cfg := loadConfig(fs, os.Args[1:])
+	if *printConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			log.Fatalf("failed printing configuration: %v", err)
+		}
+		return
+	}
+
+	// Export traces; trace context is passed on even when they aren't
+	tracingConfig, err := cfg.TracingConfig()
+	if err != nil {
+		log.Fatalf("failed loading tracing configuration: %v", err)
+	}
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		log.Fatalf("failed setting up tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	// Refuse to start on a database the migrations of this build haven't
+	// been applied to
+	checkSchema(cfg.Database.DSN())
+
 	// Initialize database connection
-	client, err := ent.Open("postgres", "host=localhost port=5432 user=postgres dbname=socialforge password=postgres sslmode=disable")
+	db, err := sql.Open("postgres", cfg.Database.DSN())
 	if err != nil {
 		log.Fatalf("failed opening connection to postgres: %v", err)
 	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
 	defer client.Close()
 
-	// Run the auto migration tool
-	if err := client.Schema.Create(context.Background()); err != nil {
-		log.Fatalf("failed creating schema resources: %v", err)
+	// Record the duration of every query and mutation. The hook comes
+	// first so the time of the other hooks is included.
+	client.Use(metrics.Hook())
+	client.Intercept(metrics.Interceptor())
+
+	// Record a span for every query and mutation, and remember which
+	// request scheduled each post so that publishing can be traced back
+	// to it
+	client.Use(tracing.ScheduleHook(), tracing.Hook())
+	client.Intercept(tracing.Interceptor())
+
+	// Move deleted influencers and posts to the trash instead of removing
+	// them, and hide the trash from queries. The hook comes first so the
+	// other hooks see the resulting update.
+	client.Use(softdelete.Hook())
+	client.Intercept(softdelete.Interceptor())
+
+	// Record an audit event for every change to users, influencers and posts
+	client.Use(audit.Hook())
+
+	// Tell watchers about changes to posts, whether made by the API or the
+	// workers
+	postEvents := postevents.NewBroker()
+	client.Use(postevents.Hook(postEvents))
+
+	// Report the server as not serving until the workers have started and
+	// while the database is unreachable
+	healthChecker := health.NewChecker(db, ocsv1.OpinionControlService_ServiceDesc.ServiceName)
+
+	// Load the credential vault keyring; without it credentials can't be stored
+	keyring, err := cfg.Keyring()
+	if err != nil {
+		log.Fatalf("failed loading vault keyring: %v", err)
+	}
+	var credentials *vault.Store
+	if keyring != nil {
+		credentials = vault.NewStore(client, keyring)
+	} else {
+		log.Printf("vault.keys is not set, storing influencer credentials is disabled")
+	}
+
+	// Configure connecting influencer accounts through OAuth; the tokens
+	// end up in the vault, so it needs the keyring too
+	connectConfig, err := cfg.ConnectConfig()
+	if err != nil {
+		log.Fatalf("failed loading OAuth connect configuration: %v", err)
+	}
+	var connectFlow *connect.Flow
+	if connectConfig != nil && credentials != nil {
+		connectFlow = connect.NewFlow(client, credentials, connectConfig)
+	} else if connectConfig != nil {
+		log.Printf("vault.keys is not set, connecting influencer accounts is disabled")
+	}
+
+	// Load the key page tokens are signed with
+	pageTokens, err := cfg.PageTokenSigner()
+	if err != nil {
+		log.Fatalf("failed loading page token key: %v", err)
+	}
+	if pageTokens == nil {
+		log.Printf("page_token_key is not set, page tokens only work on this replica until it restarts")
 	}
 
 	// Create Auth0 middleware
 	auth0Config := auth.Auth0Config{
-		Domain: os.Getenv("AUTH0_DOMAIN"),
+		Domain: cfg.Auth0.Domain,
 	}
-	auth0Middleware, err := auth.NewAuth0Middleware(auth0Config)
+	serviceAccounts := apikey.NewVerifier(client)
+	auth0Middleware, err := auth.NewAuth0Middleware(auth0Config,
+		auth.WithAPIKeyVerifier(serviceAccounts),
+		auth.WithClientCertVerifier(serviceAccounts),
+		auth.WithUserProvisioner(provision.NewProvisioner(client)),
+	)
 	if err != nil {
 		log.Fatalf("failed creating Auth0 middleware: %v", err)
 	}
 
-	// Create gRPC server
-	s := grpc.NewServer(
-		grpc.UnaryInterceptor(auth0Middleware.UnaryInterceptor),
+	// Take the client IP from X-Forwarded-For only when a trusted proxy
+	// set it
+	trustedProxies, err := cfg.TrustedProxyRanges()
+	if err != nil {
+		log.Fatalf("failed loading trusted proxies: %v", err)
+	}
+	requestInfo := requestinfo.NewInterceptor(trustedProxies)
+
+	// Create the rate limiter; buckets are shared between replicas when
+	// they are kept in Postgres
+	rateLimits, err := cfg.RateLimits()
+	if err != nil {
+		log.Fatalf("failed loading rate limits: %v", err)
+	}
+	var rateLimitStore ratelimit.Store
+	switch cfg.RateLimit.Store {
+	case "memory":
+		rateLimitStore = ratelimit.NewMemoryStore()
+	case "postgres":
+		if rateLimitStore, err = ratelimit.NewPostgresStore(context.Background(), db); err != nil {
+			log.Fatalf("failed creating rate limit store: %v", err)
+		}
+	}
+	limiter := ratelimit.NewLimiter(rateLimitStore, rateLimits)
+
+	// Record create calls made with an idempotency key so that retries
+	// get the original response
+	idempotencyKeys := idempotency.NewStore(client, cfg.IdempotencyKeyRetention)
+
+	// Load the TLS certificates; without them the server listens in plaintext
+	tlsConfig, err := cfg.TLSConfig()
+	if err != nil {
+		log.Fatalf("failed loading TLS configuration: %v", err)
+	}
+	var certs *tlsconfig.Reloader
+	serverOpts := []grpc.ServerOption{}
+	if tlsConfig != nil {
+		if certs, err = tlsconfig.NewReloader(tlsConfig); err != nil {
+			log.Fatalf("failed loading TLS certificates: %v", err)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(grpccredentials.NewTLS(certs.TLSConfig(true, "h2"))))
+	} else {
+		log.Printf("tls.cert_file is not set, serving without TLS")
+	}
+
+	// Create gRPC server. Calls are traced, except for health checks.
+	interceptors := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler(
+			otelgrpc.WithFilter(filters.Not(filters.HealthCheck())),
+		)),
+		grpc.ChainUnaryInterceptor(
+			metrics.UnaryInterceptor,
+			requestInfo.UnaryInterceptor,
+			limiter.PeerUnaryInterceptor,
+			auth0Middleware.UnaryInterceptor,
+			limiter.UnaryInterceptor,
+			idempotencyKeys.UnaryInterceptor,
+			validation.UnaryInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			metrics.StreamInterceptor,
+			requestInfo.StreamInterceptor,
+			limiter.PeerStreamInterceptor,
+			auth0Middleware.StreamInterceptor,
+			limiter.StreamInterceptor,
+			validation.StreamInterceptor,
+		),
+	}
+	svc := server.NewServer(client,
+		server.WithCredentialStore(credentials),
+		server.WithConnectFlow(connectFlow),
+		server.WithPageTokenSigner(pageTokens),
+		server.WithPostEvents(postEvents),
 	)
-	ocsv1.RegisterOpinionControlServiceServer(s, server.NewServer(client))
+	s := grpc.NewServer(append(serverOpts, interceptors...)...)
+	ocsv1.RegisterOpinionControlServiceServer(s, svc)
 
 	// Register reflection service for development
 	reflection.Register(s)
 
+	// Register the health service; it needs no credentials
+	healthChecker.Register(s)
+
 	// Create a context that we can cancel
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	// Start the post worker
-	postWorker := worker.NewPostWorker(client)
+	// The HTTP/JSON gateway calls the API in process; its server has the
+	// same interceptors but no TLS, since the connection never leaves the
+	// process
+	gatewayServer := grpc.NewServer(interceptors...)
+	ocsv1.RegisterOpinionControlServiceServer(gatewayServer, svc)
+	gatewayHandler, err := gateway.New(ctx, gatewayServer)
+	if err != nil {
+		log.Fatalf("failed creating HTTP gateway: %v", err)
+	}
+
+	// The post worker is unhealthy when it hasn't processed the due posts
+	// for three ticks
+	postWorker := worker.NewPostWorker(client,
+		worker.WithCredentialStore(credentials),
+		worker.WithPostEvents(postEvents),
+	)
+	healthChecker.AddWorker(health.PostWorkerService, postWorker.LastSuccess, 3*worker.PostInterval)
+	go healthChecker.Start(ctx)
+
+	// The schema is up to date, so the workers can start
+	healthChecker.SetMigrated(ctx)
 	go postWorker.Start(ctx)
 
+	// Delete expired idempotency keys
+	go idempotencyKeys.Start(ctx)
+
+	// Empty the trash of what is past the retention
+	go worker.NewTrashPurger(client, cfg.TrashRetention).Start(ctx)
+
+	// Refresh connected accounts' tokens before they expire
+	if connectFlow != nil {
+		go worker.NewTokenRefresher(client, credentials, connectConfig).Start(ctx)
+	}
+
+	// Pick up rotated certificates
+	if certs != nil {
+		go certs.Start(ctx)
+	}
+
+	// Serve the gateway, the OAuth callback, the health checks and the
+	// metrics over HTTP
+	mux := http.NewServeMux()
+	mux.Handle("/", gatewayHandler)
+	mux.Handle(health.LivenessPath, healthChecker.Handler())
+	mux.Handle(health.ReadinessPath, healthChecker.Handler())
+	mux.Handle(metrics.Path, metrics.Handler())
+	if connectFlow != nil {
+		mux.Handle(connect.CallbackPath, connectFlow.CallbackHandler())
+	}
+	httpServer := &http.Server{Addr: cfg.HTTPAddr, Handler: mux}
+	go func() {
+		log.Printf("HTTP server listening at %v", cfg.HTTPAddr)
+		var err error
+		if certs != nil {
+			// Browsers use this listener too, so client certificates are
+			// never requested on it
+			httpServer.TLSConfig = certs.TLSConfig(false, "h2", "http/1.1")
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			log.Fatalf("failed to serve HTTP: %v", err)
+		}
+	}()
+
 	// Handle graceful shutdown
 	go func() {
 		sigCh := make(chan os.Signal, 1)
@@ -64,17 +328,38 @@ func main() {
 		<-sigCh
 		log.Println("Shutting down gRPC server...")
 		cancel()
+		httpServer.Shutdown(context.Background())
+		gatewayServer.GracefulStop()
 		s.GracefulStop()
 	}()
 
 	// Start listening
-	lis, err := net.Listen("tcp", ":50051")
+	lis, err := net.Listen("tcp", cfg.GRPCAddr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	log.Printf("Server listening at %v", lis.Addr())
+	if tlsConfig != nil {
+		log.Printf("Server listening at %v with %s", lis.Addr(), tlsConfig.Describe())
+	} else {
+		log.Printf("Server listening at %v", lis.Addr())
+	}
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// loadConfig loads the configuration with the settings given in args,
+// exiting if it is invalid
+func loadConfig(fs *flag.FlagSet, args []string) *config.Config {
+	flags := config.RegisterFlags(fs)
+	fs.Parse(args)
+	if fs.NArg() > 0 {
+		log.Fatalf("unexpected arguments: %v", fs.Args())
+	}
+	cfg, err := config.Load(flags)
+	if err != nil {
+		log.Fatalf("failed loading configuration: %v", err)
+	}
+	return cfg
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/WuPinYi/SocialForge/internal/migrations"
+)
+
+const migrateUsage = `usage: server migrate <command> [flags]
+
+The server's configuration flags, environment and config file apply.
+
+commands:
+  up                apply every pending migration
+  down [N]          revert the last N migrations, 1 by default
+  status            list the migrations and whether they have been applied
+  force VERSION     record VERSION as applied without running anything
+
+Only one process migrates at a time; the others wait for it. If a migration
+fails halfway the database is marked dirty: fix it by hand, then run
+"server migrate force" with the last version that is fully applied.`
+
+// runMigrate implements the "migrate" maintenance subcommand
+func runMigrate(args []string) {
+	if len(args) < 1 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(2)
+	}
+	command, args := args[0], args[1:]
+
+	// The command's own argument comes before the flags
+	var arg string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		arg, args = args[0], args[1:]
+	}
+
+	steps := 1
+	var version uint64
+	var err error
+	switch command {
+	case "up", "status":
+		if arg != "" {
+			log.Fatalf("unexpected argument: %s", arg)
+		}
+	case "down":
+		if arg != "" {
+			if steps, err = strconv.Atoi(arg); err != nil || steps < 1 {
+				log.Fatalf("invalid number of migrations %q", arg)
+			}
+		}
+	case "force":
+		if version, err = strconv.ParseUint(arg, 10, 0); err != nil {
+			log.Fatalf("invalid version %q", arg)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(2)
+	}
+	cfg := loadConfig(flag.NewFlagSet("server migrate "+command, flag.ExitOnError), args)
+
+	m, err := migrations.New(cfg.Database.DSN())
+	if err != nil {
+		log.Fatalf("failed opening migrations: %v", err)
+	}
+	defer m.Close()
+
+	switch command {
+	case "up":
+		err = m.Up()
+		if errors.Is(err, migrations.ErrNoChange) {
+			log.Printf("No pending migrations")
+			return
+		}
+	case "down":
+		err = m.Down(steps)
+	case "force":
+		err = m.Force(uint(version))
+	case "status":
+		err = printStatus(m)
+	}
+	if err != nil {
+		log.Fatalf("failed to migrate %s: %v", command, err)
+	}
+	if command != "status" {
+		printStatus(m)
+	}
+}
+
+// printStatus lists the migrations and whether they have been applied
+func printStatus(m *migrations.Migrator) error {
+	status, err := m.Status()
+	if err != nil {
+		return err
+	}
+	for _, mig := range status.Migrations {
+		state := "pending"
+		if mig.Applied {
+			state = "applied"
+		}
+		if status.Dirty && mig.Version == status.Current {
+			state = "dirty"
+		}
+		fmt.Printf("%d_%s\t%s\n", mig.Version, mig.Name, state)
+	}
+	switch {
+	case status.Dirty:
+		fmt.Printf("Database is dirty at version %d\n", status.Current)
+	case status.Current > status.Latest:
+		fmt.Printf("Database is at version %d, ahead of this build\n", status.Current)
+	case status.Behind():
+		fmt.Printf("Database is at version %d, %d is the latest\n", status.Current, status.Latest)
+	default:
+		fmt.Printf("Database is up to date at version %d\n", status.Current)
+	}
+	return nil
+}
+
+// checkSchema exits unless every migration of this build has been applied.
+// A database ahead of this build is allowed, so that an older build can be
+// rolled out again after migrating.
+func checkSchema(dsn string) {
+	m, err := migrations.New(dsn)
+	if err != nil {
+		log.Fatalf("failed opening migrations: %v", err)
+	}
+	defer m.Close()
+
+	status, err := m.Status()
+	if err != nil {
+		log.Fatalf("failed checking database schema: %v", err)
+	}
+	switch {
+	case status.Dirty:
+		log.Fatalf("database schema is dirty at version %d; fix the failed migration and run \"server migrate force\"", status.Current)
+	case status.Behind():
+		log.Fatalf("database schema is at version %d but this build needs %d; run \"server migrate up\"", status.Current, status.Latest)
+	case status.Current > status.Latest:
+		log.Printf("Database schema is at version %d, ahead of this build's %d", status.Current, status.Latest)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/vault"
+)
+
+const vaultUsage = `usage: server vault <command> [flags]
+
+The server's configuration flags, environment and config file apply.
+
+commands:
+  rewrap    re-encrypt every credential's data key with the active key version
+
+To rotate the key-encryption key, add a new version to the keyring, make it
+active, run "server vault rewrap", and remove the old version once rewrap
+reports nothing left to do.`
+
+// runVault implements the "vault" maintenance subcommand
+func runVault(args []string) {
+	if len(args) < 1 || args[0] != "rewrap" {
+		fmt.Fprintln(os.Stderr, vaultUsage)
+		os.Exit(2)
+	}
+	cfg := loadConfig(flag.NewFlagSet("server vault rewrap", flag.ExitOnError), args[1:])
+
+	keyring, err := cfg.Keyring()
+	if err != nil {
+		log.Fatalf("failed loading vault keyring: %v", err)
+	}
+	if keyring == nil {
+		log.Fatalf("vault.keys must be set")
+	}
+
+	client, err := ent.Open("postgres", cfg.Database.DSN())
+	if err != nil {
+		log.Fatalf("failed opening connection to postgres: %v", err)
+	}
+	defer client.Close()
+
+	n, err := vault.NewStore(client, keyring).RewrapAll(context.Background())
+	if err != nil {
+		log.Fatalf("failed rewrapping credentials after %d: %v", n, err)
+	}
+	log.Printf("Rewrapped %d credentials with key version %d", n, keyring.ActiveVersion())
+}
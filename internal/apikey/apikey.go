@@ -0,0 +1,161 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/serviceaccount"
+)
+
+// lastUsedResolution limits how often last_used_at is written for a busy key
+const lastUsedResolution = time.Minute
+
+// Key is a freshly generated API key. The plaintext Value is only ever
+// shown to the caller once; Prefix and Hash are what gets persisted.
+type Key struct {
+	Value  string
+	Prefix string
+	Hash   string
+}
+
+// Generate creates a new random API key of the form sfk_<id>_<secret>
+func Generate() (*Key, error) {
+	id := make([]byte, 4)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate key id: %v", err)
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate key secret: %v", err)
+	}
+
+	prefix := auth.APIKeyPrefix + hex.EncodeToString(id)
+	value := prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	return &Key{
+		Value:  value,
+		Prefix: prefix,
+		Hash:   Hash(value),
+	}, nil
+}
+
+// Hash returns the hex-encoded SHA-256 digest stored for a key. Keys carry
+// 256 bits of entropy, so a fast unsalted hash is sufficient.
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Prefix returns the lookup prefix of key, or false if key is malformed
+func Prefix(key string) (string, bool) {
+	if !strings.HasPrefix(key, auth.APIKeyPrefix) {
+		return "", false
+	}
+	// The secret may itself contain underscores, so split on the first
+	// one after the fixed prefix
+	i := strings.IndexByte(key[len(auth.APIKeyPrefix):], '_')
+	if i <= 0 {
+		return "", false
+	}
+	return key[:len(auth.APIKeyPrefix)+i], true
+}
+
+// Verifier authenticates API keys and client certificate identities against
+// the service accounts stored in the database. It implements
+// auth.APIKeyVerifier and auth.ClientCertVerifier.
+type Verifier struct {
+	client *ent.Client
+}
+
+// NewVerifier creates a new API key verifier
+func NewVerifier(client *ent.Client) *Verifier {
+	return &Verifier{
+		client: client,
+	}
+}
+
+// VerifyAPIKey implements auth.APIKeyVerifier
+func (v *Verifier) VerifyAPIKey(ctx context.Context, key string) (*auth.Principal, error) {
+	prefix, ok := Prefix(key)
+	if !ok {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	sa, err := v.client.ServiceAccount.Query().
+		Where(serviceaccount.KeyPrefixEQ(prefix)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, auth.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(Hash(key)), []byte(sa.KeyHash)) != 1 {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	if !active(sa) {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	return v.principal(ctx, sa), nil
+}
+
+// VerifyClientCert implements auth.ClientCertVerifier. Revoking or expiring
+// the service account disables its certificate identity as well.
+func (v *Verifier) VerifyClientCert(ctx context.Context, identity string) (*auth.Principal, error) {
+	sa, err := v.client.ServiceAccount.Query().
+		Where(serviceaccount.ClientCertIdentityEQ(identity)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, auth.ErrUnknownClientCert
+		}
+		return nil, err
+	}
+	if !active(sa) {
+		return nil, auth.ErrUnknownClientCert
+	}
+	return v.principal(ctx, sa), nil
+}
+
+// active reports whether the service account is neither revoked nor expired
+func active(sa *ent.ServiceAccount) bool {
+	if sa.RevokedAt != nil {
+		return false
+	}
+	return sa.ExpiresAt == nil || time.Now().Before(*sa.ExpiresAt)
+}
+
+// principal records the use of the service account and returns the
+// principal it authenticates
+func (v *Verifier) principal(ctx context.Context, sa *ent.ServiceAccount) *auth.Principal {
+	now := time.Now()
+
+	// Track usage, but don't write on every single request
+	if sa.LastUsedAt == nil || now.Sub(*sa.LastUsedAt) >= lastUsedResolution {
+		if err := v.client.ServiceAccount.UpdateOneID(sa.ID).SetLastUsedAt(now).Exec(ctx); err != nil {
+			log.Printf("Error recording last use of service account %s: %v", sa.ID, err)
+		}
+	}
+
+	return &auth.Principal{
+		Kind:             auth.PrincipalServiceAccount,
+		Subject:          sa.Edges.Owner.Auth0ID,
+		Email:            sa.Edges.Owner.Email,
+		Name:             sa.Name,
+		UserID:           sa.Edges.Owner.ID,
+		ServiceAccountID: sa.ID,
+		Scopes:           sa.Scopes,
+	}
+}
@@ -0,0 +1,88 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+)
+
+func TestPrefix(t *testing.T) {
+	key, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prefix, ok := Prefix(key.Value); !ok || prefix != key.Prefix {
+		t.Errorf("Prefix(%q): got %q, %v, want %q", key.Value, prefix, ok, key.Prefix)
+	}
+	if key.Hash != Hash(key.Value) {
+		t.Errorf("got hash %q, want %q", key.Hash, Hash(key.Value))
+	}
+
+	for _, malformed := range []string{"", "sfk_", "sfk__secret", "xyz_1234_secret"} {
+		if _, ok := Prefix(malformed); ok {
+			t.Errorf("Prefix(%q): got ok, want malformed", malformed)
+		}
+	}
+}
+
+func TestVerifyAPIKey(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	ctx := context.Background()
+	v := NewVerifier(client)
+
+	owner := client.User.Create().SetID("user-1").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	create := func(id string) *Key {
+		key, err := Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.ServiceAccount.Create().
+			SetID(id).
+			SetName("ci").
+			SetKeyPrefix(key.Prefix).
+			SetKeyHash(key.Hash).
+			SetScopes([]string{auth.ScopeWrite}).
+			SetOwner(owner).
+			ExecX(ctx)
+		return key
+	}
+
+	key := create("sa-1")
+	principal, err := v.VerifyAPIKey(ctx, key.Value)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if principal.ServiceAccountID != "sa-1" || principal.UserID != owner.ID || principal.Subject != owner.Auth0ID ||
+		!slices.Equal(principal.Scopes, []string{auth.ScopeWrite}) {
+		t.Errorf("got principal %+v", principal)
+	}
+	if sa := client.ServiceAccount.GetX(ctx, "sa-1"); sa.LastUsedAt == nil {
+		t.Error("last use wasn't recorded")
+	}
+
+	revoked := create("sa-revoked")
+	client.ServiceAccount.UpdateOneID("sa-revoked").SetRevokedAt(time.Now()).ExecX(ctx)
+	expired := create("sa-expired")
+	client.ServiceAccount.UpdateOneID("sa-expired").SetExpiresAt(time.Now().Add(-time.Minute)).ExecX(ctx)
+
+	for name, value := range map[string]string{
+		"wrong secret": key.Prefix + "_wrong",
+		"unknown":      "sfk_00000000_secret",
+		"malformed":    "not-a-key",
+		"revoked":      revoked.Value,
+		"expired":      expired.Value,
+	} {
+		if _, err := v.VerifyAPIKey(ctx, value); !errors.Is(err, auth.ErrInvalidAPIKey) {
+			t.Errorf("%s key: got %v, want %v", name, err, auth.ErrInvalidAPIKey)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+	"github.com/WuPinYi/SocialForge/internal/requestinfo"
+	"github.com/WuPinYi/SocialForge/internal/softdelete"
+)
+
+// ActorSystem is the actor kind recorded for changes made outside of an
+// authenticated request, such as by the post worker
+const ActorSystem = "system"
+
+// auditedMutation is implemented by the generated mutations of every audited type
+type auditedMutation interface {
+	ent.Mutation
+	Client() *ent.Client
+	ID() (string, bool)
+	IDs(ctx context.Context) ([]string, error)
+}
+
+// snapshotFunc loads the current state of the given entities keyed by ID,
+// including those in the trash
+type snapshotFunc func(ctx context.Context, client *ent.Client, ids []string) (map[string]map[string]any, error)
+
+// snapshots holds a loader for every audited entity type
+var snapshots = map[string]snapshotFunc{
+	ent.TypeUser: func(ctx context.Context, client *ent.Client, ids []string) (map[string]map[string]any, error) {
+		users, err := client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return toMaps(users, func(u *ent.User) string { return u.ID })
+	},
+	ent.TypeInfluencer: func(ctx context.Context, client *ent.Client, ids []string) (map[string]map[string]any, error) {
+		influencers, err := client.Influencer.Query().Where(influencer.IDIn(ids...)).All(softdelete.Skip(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return toMaps(influencers, func(i *ent.Influencer) string { return i.ID })
+	},
+	ent.TypePost: func(ctx context.Context, client *ent.Client, ids []string) (map[string]map[string]any, error) {
+		posts, err := client.Post.Query().Where(post.IDIn(ids...)).All(softdelete.Skip(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return toMaps(posts, func(p *ent.Post) string { return p.ID })
+	},
+}
+
+// Hook returns an ent hook that records an AuditEvent for every create,
+// update and delete of a User, Influencer or Post. The event is written
+// with the mutation's client: for a mutation in a transaction it commits or
+// rolls back together with the change. Outside a transaction the change is
+// already saved when the event is written, so if writing the event fails
+// the mutation returns the error but the change stays.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			snapshot, ok := snapshots[m.Type()]
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			am, ok := m.(auditedMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			client := am.Client()
+			var ids []string
+			var before map[string]map[string]any
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = am.IDs(ctx); err != nil {
+					return nil, fmt.Errorf("audit: failed to resolve ids: %w", err)
+				}
+				if len(ids) == 0 {
+					return next.Mutate(ctx, m)
+				}
+				if before, err = snapshot(ctx, client, ids); err != nil {
+					return nil, fmt.Errorf("audit: failed to load previous state: %w", err)
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			var after map[string]map[string]any
+			if m.Op().Is(ent.OpCreate) {
+				id, _ := am.ID()
+				ids = []string{id}
+			}
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				if after, err = snapshot(ctx, client, ids); err != nil {
+					return nil, fmt.Errorf("audit: failed to load new state: %w", err)
+				}
+			}
+
+			if err := record(ctx, client, m, ids, before, after); err != nil {
+				return nil, err
+			}
+			return v, nil
+		})
+	}
+}
+
+// record writes one audit event per affected entity
+func record(ctx context.Context, client *ent.Client, m ent.Mutation, ids []string, before, after map[string]map[string]any) error {
+	builders := make([]*ent.AuditEventCreate, 0, len(ids))
+	for _, id := range ids {
+		changes := diff(before[id], after[id])
+		if m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && !meaningful(changes) {
+			continue
+		}
+
+		create := client.AuditEvent.Create().
+			SetID(newID()).
+			SetActorKind(ActorSystem).
+			SetOperation(operation(m.Op(), changes)).
+			SetEntityType(m.Type()).
+			SetEntityID(id).
+			SetChanges(changes)
+		if p, err := auth.GetPrincipalFromContext(ctx); err == nil {
+			create.SetActorKind(string(p.Kind)).
+				SetActorID(p.UserID).
+				SetActorSubject(p.Subject).
+				SetServiceAccountID(p.ServiceAccountID)
+		}
+		if info := requestinfo.FromContext(ctx); info != nil {
+			create.SetRequestID(info.RequestID).
+				SetClientIP(info.ClientIP)
+		}
+		builders = append(builders, create)
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+
+	if err := client.AuditEvent.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("audit: failed to record event: %w", err)
+	}
+	return nil
+}
+
+// newID returns a time-ordered ID so that audit events sort chronologically
+func newID() string {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.New().String()
+	}
+	return id.String()
+}
+
+// operation returns the audit name of an ent operation. Updates that move
+// an entity to or from the trash are recorded as "delete" and "restore".
+func operation(op ent.Op, changes map[string]any) string {
+	switch {
+	case op.Is(ent.OpCreate):
+		return "create"
+	case op.Is(ent.OpUpdate | ent.OpUpdateOne):
+		if change, ok := changes["deleted_at"].(map[string]any); ok {
+			if _, deleted := change["after"]; deleted {
+				return "delete"
+			}
+			return "restore"
+		}
+		return "update"
+	default:
+		return "delete"
+	}
+}
+
+// diff returns the fields that differ between two snapshots as
+// {"before": ..., "after": ...} pairs. Either snapshot may be nil.
+func diff(before, after map[string]any) map[string]any {
+	changes := make(map[string]any)
+	for k, v := range after {
+		if old, ok := before[k]; !ok || !reflect.DeepEqual(old, v) {
+			change := map[string]any{"after": v}
+			if ok {
+				change["before"] = old
+			}
+			changes[k] = change
+		}
+	}
+	for k, old := range before {
+		if _, ok := after[k]; !ok {
+			changes[k] = map[string]any{"before": old}
+		}
+	}
+	return changes
+}
+
+// meaningful reports whether changes contain more than the bumped updated_at
+func meaningful(changes map[string]any) bool {
+	for k := range changes {
+		if k != "updated_at" {
+			return true
+		}
+	}
+	return false
+}
+
+// toMaps converts entities to their JSON representation keyed by ID. Edges
+// are dropped; sensitive fields are already excluded by their JSON tags.
+func toMaps[T any](nodes []T, id func(T) string) (map[string]map[string]any, error) {
+	out := make(map[string]map[string]any, len(nodes))
+	for _, n := range nodes {
+		b, err := json.Marshal(n)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]any
+		if err := json.Unmarshal(b, &fields); err != nil {
+			return nil, err
+		}
+		delete(fields, "edges")
+		out[id(n)] = fields
+	}
+	return out, nil
+}
@@ -2,8 +2,10 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/auth0/go-jwt-middleware/v2/jwks"
 	"github.com/auth0/go-jwt-middleware/v2/validator"
@@ -13,6 +15,28 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// APIKeyPrefix marks a bearer token as an API key rather than a JWT
+const APIKeyPrefix = "sfk_"
+
+// APIKeyHeader is the metadata key machine clients may use to send an API key
+const APIKeyHeader = "x-api-key"
+
+// ErrInvalidAPIKey is returned by an APIKeyVerifier for unknown, revoked or
+// expired keys.
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// APIKeyVerifier resolves an API key to the principal it authenticates
+type APIKeyVerifier interface {
+	VerifyAPIKey(ctx context.Context, key string) (*Principal, error)
+}
+
+// UserProvisioner makes sure a User row exists for an authenticated
+// principal and keeps its profile in sync with the token claims. It returns
+// the ID of the user the principal acts as.
+type UserProvisioner interface {
+	ProvisionUser(ctx context.Context, p *Principal) (string, error)
+}
+
 // Auth0Config holds the configuration for Auth0
 type Auth0Config struct {
 	Domain string
@@ -32,11 +56,40 @@ func (c CustomClaims) Validate(ctx context.Context) error {
 
 // Auth0Middleware handles Auth0 authentication
 type Auth0Middleware struct {
-	validator *validator.Validator
+	validator   *validator.Validator
+	apiKeys     APIKeyVerifier
+	clientCerts ClientCertVerifier
+	users       UserProvisioner
+}
+
+// Option configures optional behaviour of the Auth0Middleware
+type Option func(*Auth0Middleware)
+
+// WithAPIKeyVerifier enables API key authentication for machine clients
+func WithAPIKeyVerifier(v APIKeyVerifier) Option {
+	return func(m *Auth0Middleware) {
+		m.apiKeys = v
+	}
+}
+
+// WithClientCertVerifier lets callers that send no token authenticate with
+// a verified TLS client certificate
+func WithClientCertVerifier(v ClientCertVerifier) Option {
+	return func(m *Auth0Middleware) {
+		m.clientCerts = v
+	}
+}
+
+// WithUserProvisioner creates or updates the caller's User row on every
+// authenticated request
+func WithUserProvisioner(p UserProvisioner) Option {
+	return func(m *Auth0Middleware) {
+		m.users = p
+	}
 }
 
 // NewAuth0Middleware creates a new Auth0 middleware
-func NewAuth0Middleware(config Auth0Config) (*Auth0Middleware, error) {
+func NewAuth0Middleware(config Auth0Config, opts ...Option) (*Auth0Middleware, error) {
 	issuerURL := fmt.Sprintf("https://%s/", config.Domain)
 	issuerURLParsed, err := url.Parse(issuerURL)
 	if err != nil {
@@ -60,26 +113,97 @@ func NewAuth0Middleware(config Auth0Config) (*Auth0Middleware, error) {
 		return nil, fmt.Errorf("failed to create validator: %v", err)
 	}
 
-	return &Auth0Middleware{
+	m := &Auth0Middleware{
 		validator: jwtValidator,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m, nil
 }
 
 // UnaryInterceptor implements the gRPC unary interceptor for Auth0 authentication
 func (m *Auth0Middleware) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	// Skip authentication for reflection service
-	if info.FullMethod == "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo" {
+	// Skip authentication for the reflection and health services
+	if isPublic(info.FullMethod) {
 		return handler(ctx, req)
 	}
 
+	ctx, err := m.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements the gRPC stream interceptor for Auth0 authentication
+func (m *Auth0Middleware) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	// Skip authentication for the reflection and health services
+	if isPublic(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	ctx, err := m.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+}
+
+func isPublic(method string) bool {
+	return strings.HasPrefix(method, "/grpc.reflection.") || strings.HasPrefix(method, "/grpc.health.v1.")
+}
+
+// authorize authenticates the caller of method and returns a context
+// carrying its principal
+func (m *Auth0Middleware) authorize(ctx context.Context, method string) (context.Context, error) {
+	principal, err := m.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Service accounts are limited to the scopes they were granted
+	if scope := requiredScope(method); !principal.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "service account lacks the %q scope", scope)
+	}
+
+	// Add the principal to the context. Provisioning below already runs
+	// with it so that changes to the User row are attributed to the caller.
+	ctx = NewContext(ctx, principal)
+
+	// Make sure the caller has a User row before any handler needs it
+	if m.users != nil && principal.UserID == "" {
+		userID, err := m.users.ProvisionUser(ctx, principal)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Errorf(codes.Internal, "failed to provision user: %v", err)
+		}
+		principal.UserID = userID
+	}
+
+	return ctx, nil
+}
+
+// authenticate resolves the caller's credentials from the request metadata
+func (m *Auth0Middleware) authenticate(ctx context.Context) (*Principal, error) {
 	// Get the authorization header from metadata
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
 	}
 
+	if apiKey := md.Get(APIKeyHeader); len(apiKey) > 0 {
+		return m.authenticateAPIKey(ctx, apiKey[0])
+	}
+
 	authHeader := md.Get("authorization")
 	if len(authHeader) == 0 {
+		// Internal callers may authenticate with a client certificate instead
+		if identity := ClientCertIdentity(ctx); identity != "" && m.clientCerts != nil {
+			return m.authenticateClientCert(ctx, identity)
+		}
 		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
 	}
 
@@ -90,6 +214,10 @@ func (m *Auth0Middleware) UnaryInterceptor(ctx context.Context, req interface{},
 	}
 	token = token[7:]
 
+	if strings.HasPrefix(token, APIKeyPrefix) {
+		return m.authenticateAPIKey(ctx, token)
+	}
+
 	// Validate the token
 	claims, err := m.validator.ValidateToken(ctx, token)
 	if err != nil {
@@ -97,21 +225,73 @@ func (m *Auth0Middleware) UnaryInterceptor(ctx context.Context, req interface{},
 	}
 
 	// Extract custom claims
-	customClaims, ok := claims.(*CustomClaims)
+	customClaims, ok := claims.(*validator.ValidatedClaims)
+	if !ok {
+		return nil, status.Error(codes.Internal, "failed to extract custom claims")
+	}
+	profile, ok := customClaims.CustomClaims.(*CustomClaims)
 	if !ok {
 		return nil, status.Error(codes.Internal, "failed to extract custom claims")
 	}
 
-	// Add user information to the context
-	ctx = context.WithValue(ctx, "user", customClaims)
-	return handler(ctx, req)
+	return &Principal{
+		Kind:    PrincipalUser,
+		Subject: customClaims.RegisteredClaims.Subject,
+		Email:   profile.Email,
+		Name:    profile.Name,
+	}, nil
 }
 
-// GetUserFromContext extracts user information from the context
-func GetUserFromContext(ctx context.Context) (*CustomClaims, error) {
-	claims, ok := ctx.Value("user").(*CustomClaims)
-	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+// authenticateAPIKey resolves an API key through the configured verifier
+func (m *Auth0Middleware) authenticateAPIKey(ctx context.Context, key string) (*Principal, error) {
+	if m.apiKeys == nil {
+		return nil, status.Error(codes.Unauthenticated, "API key authentication is not enabled")
+	}
+
+	principal, err := m.apiKeys.VerifyAPIKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrInvalidAPIKey) {
+			return nil, status.Error(codes.Unauthenticated, "invalid API key")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to verify API key: %v", err)
+	}
+	return principal, nil
+}
+
+// authenticateClientCert resolves a client certificate identity through the
+// configured verifier
+func (m *Auth0Middleware) authenticateClientCert(ctx context.Context, identity string) (*Principal, error) {
+	principal, err := m.clientCerts.VerifyClientCert(ctx, identity)
+	if err != nil {
+		if errors.Is(err, ErrUnknownClientCert) {
+			return nil, status.Errorf(codes.Unauthenticated, "client certificate %q is not bound to a service account", identity)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to verify client certificate: %v", err)
 	}
-	return claims, nil
+	return principal, nil
+}
+
+// readOnlyPrefixes are the method name prefixes of read-only RPCs
+var readOnlyPrefixes = []string{"Get", "List", "Export", "Watch", "Search"}
+
+// requiredScope returns the scope a service account needs to call method.
+// Read-only RPCs need ScopeRead, everything else needs ScopeWrite.
+func requiredScope(method string) string {
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return ScopeRead
+		}
+	}
+	return ScopeWrite
+}
+
+// wrappedStream overrides the context of a grpc.ServerStream
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
 }
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ErrUnknownClientCert is returned by a ClientCertVerifier for identities
+// that don't belong to an active service account
+var ErrUnknownClientCert = errors.New("unknown client certificate")
+
+// ClientCertVerifier resolves the identity of a verified TLS client
+// certificate to the principal it authenticates
+type ClientCertVerifier interface {
+	VerifyClientCert(ctx context.Context, identity string) (*Principal, error)
+}
+
+// ClientCertIdentity returns the identity of the caller's TLS client
+// certificate, or "" if the caller presented none or it was not verified
+// against the client CAs. The identity is the certificate's first URI SAN,
+// e.g. a SPIFFE ID, or else its subject common name.
+func ClientCertIdentity(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	leaf := tlsInfo.State.VerifiedChains[0][0]
+	if len(leaf.URIs) > 0 {
+		return leaf.URIs[0].String()
+	}
+	return leaf.Subject.CommonName
+}
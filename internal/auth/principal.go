@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PrincipalKind describes how a principal authenticated
+type PrincipalKind string
+
+const (
+	// PrincipalUser is a human caller authenticated with an Auth0 JWT
+	PrincipalUser PrincipalKind = "user"
+	// PrincipalServiceAccount is a machine client authenticated with an API key
+	PrincipalServiceAccount PrincipalKind = "service_account"
+)
+
+// Scopes that can be granted to a service account. Each scope implies the
+// ones listed before it.
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+	ScopeAdmin = "admin"
+)
+
+// Scopes lists every valid scope in ascending order of privilege.
+var Scopes = []string{ScopeRead, ScopeWrite, ScopeAdmin}
+
+// Principal is the authenticated caller of an RPC. Handlers see the same
+// principal regardless of whether the caller presented a JWT or an API key.
+type Principal struct {
+	Kind PrincipalKind
+	// Subject is the Auth0 subject the principal acts as. Service accounts
+	// act as the user that owns them.
+	Subject string
+	Email   string
+	Name    string
+	// UserID is the ID of the User row the principal acts as. It is filled
+	// in by the UserProvisioner before the handler runs.
+	UserID string
+	// ServiceAccountID is set when the principal authenticated with an API key
+	ServiceAccountID string
+	// Scopes restrict what a service account may do. User principals are
+	// not restricted by scopes.
+	Scopes []string
+}
+
+// IsServiceAccount reports whether the principal authenticated with an API key
+func (p *Principal) IsServiceAccount() bool {
+	return p.Kind == PrincipalServiceAccount
+}
+
+// HasScope reports whether the principal is allowed to act with the given scope
+func (p *Principal) HasScope(scope string) bool {
+	if !p.IsServiceAccount() {
+		return true
+	}
+	want := slices.Index(Scopes, scope)
+	if want < 0 {
+		return false
+	}
+	for _, s := range p.Scopes {
+		if slices.Index(Scopes, s) >= want {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAdmin reports whether the principal has administrative access
+func (p *Principal) IsAdmin() bool {
+	return p.Subject == "admin" && p.HasScope(ScopeAdmin)
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying the principal
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// GetPrincipalFromContext extracts the authenticated principal from the context
+func GetPrincipalFromContext(ctx context.Context) (*Principal, error) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+	return p, nil
+}
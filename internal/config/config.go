@@ -0,0 +1,354 @@
+package config
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/WuPinYi/SocialForge/internal/connect"
+	"github.com/WuPinYi/SocialForge/internal/idempotency"
+	"github.com/WuPinYi/SocialForge/internal/pagetoken"
+	"github.com/WuPinYi/SocialForge/internal/ratelimit"
+	"github.com/WuPinYi/SocialForge/internal/tlsconfig"
+	"github.com/WuPinYi/SocialForge/internal/tracing"
+	"github.com/WuPinYi/SocialForge/internal/vault"
+	"github.com/WuPinYi/SocialForge/internal/worker"
+)
+
+// Config is the configuration of the server. Every setting has a key in the
+// YAML file and an environment variable, named by the yaml and env tags.
+// Settings that aren't secret can also be set with a flag, named after the
+// key with dashes, e.g. --database-host. Secrets can be read from the file
+// named by their environment variable with a _FILE suffix.
+type Config struct {
+	GRPCAddr string `yaml:"grpc_addr" env:"GRPC_ADDR" usage:"address the gRPC API listens on"`
+	HTTPAddr string `yaml:"http_addr" env:"HTTP_ADDR" usage:"address the HTTP gateway, OAuth callback and health checks listen on"`
+
+	TrustedProxies []string `yaml:"trusted_proxies" env:"TRUSTED_PROXIES" usage:"addresses or CIDR ranges of proxies whose X-Forwarded-For is trusted"`
+
+	Database  Database  `yaml:"database"`
+	Auth0     Auth0     `yaml:"auth0"`
+	TLS       TLS       `yaml:"tls"`
+	Vault     Vault     `yaml:"vault"`
+	OAuth     OAuth     `yaml:"oauth"`
+	RateLimit RateLimit `yaml:"rate_limit"`
+	Tracing   Tracing   `yaml:"tracing"`
+
+	PageTokenKey            string        `yaml:"page_token_key" env:"PAGE_TOKEN_KEY" secret:"true" usage:"base64 key page tokens are signed with, shared by all replicas"`
+	IdempotencyKeyRetention time.Duration `yaml:"idempotency_key_retention" env:"IDEMPOTENCY_KEY_RETENTION" usage:"how long idempotency keys are kept"`
+	TrashRetention          time.Duration `yaml:"trash_retention" env:"TRASH_RETENTION" usage:"how long deleted influencers and posts are kept in the trash"`
+}
+
+// Database is the Postgres connection
+type Database struct {
+	URL      string `yaml:"url" env:"DATABASE_URL" secret:"true" usage:"Postgres connection URL; replaces the other database settings"`
+	Host     string `yaml:"host" env:"DB_HOST" usage:"Postgres host"`
+	Port     int    `yaml:"port" env:"DB_PORT" usage:"Postgres port"`
+	User     string `yaml:"user" env:"DB_USER" usage:"Postgres user"`
+	Password string `yaml:"password" env:"DB_PASSWORD" secret:"true" usage:"Postgres password"`
+	Name     string `yaml:"name" env:"DB_NAME" usage:"Postgres database"`
+	SSLMode  string `yaml:"sslmode" env:"DB_SSLMODE" usage:"Postgres sslmode, e.g. disable or verify-full"`
+}
+
+// Auth0 is the tenant user tokens are issued by
+type Auth0 struct {
+	Domain string `yaml:"domain" env:"AUTH0_DOMAIN" usage:"Auth0 tenant domain, e.g. example.eu.auth0.com"`
+}
+
+// TLS holds the certificate files. Without a certificate the server
+// listens in plaintext.
+type TLS struct {
+	CertFile     string `yaml:"cert_file" env:"TLS_CERT_FILE" usage:"PEM certificate chain served to clients"`
+	KeyFile      string `yaml:"key_file" env:"TLS_KEY_FILE" usage:"PEM private key of the certificate"`
+	ClientCAFile string `yaml:"client_ca_file" env:"TLS_CLIENT_CA_FILE" usage:"PEM bundle client certificates are verified against"`
+	ClientAuth   string `yaml:"client_auth" env:"TLS_CLIENT_AUTH" usage:"optional or require a client certificate"`
+}
+
+// Vault holds the key-encryption keys of the credential vault. Without
+// keys, storing credentials is disabled.
+type Vault struct {
+	Keys      string `yaml:"keys" env:"VAULT_KEYS" secret:"true" usage:"key-encryption keys as version:base64key entries"`
+	ActiveKey int    `yaml:"active_key" env:"VAULT_ACTIVE_KEY" usage:"key version new credentials are sealed with; defaults to the highest"`
+}
+
+// OAuth configures connecting influencer accounts. Each platform is
+// configured under providers, or with OAUTH_<PLATFORM>_* variables named
+// after the env tags of Provider, e.g. OAUTH_TWITTER_CLIENT_ID.
+type OAuth struct {
+	Platforms       []string             `yaml:"platforms" env:"OAUTH_PLATFORMS" usage:"platforms that can be connected"`
+	RedirectURL     string               `yaml:"redirect_url" env:"OAUTH_REDIRECT_URL" usage:"public URL of the OAuth callback"`
+	ReturnURL       string               `yaml:"return_url" env:"OAUTH_RETURN_URL" usage:"where the browser is sent after the callback"`
+	AppRedirectURLs []string             `yaml:"app_redirect_urls" env:"OAUTH_APP_REDIRECT_URLS" usage:"redirect URLs native apps may use, e.g. http://127.0.0.1/callback; loopback URLs match any port"`
+	Providers       map[string]*Provider `yaml:"providers"`
+}
+
+// Provider is the OAuth2 configuration of one platform
+type Provider struct {
+	ClientID       string   `yaml:"client_id" env:"CLIENT_ID"`
+	ClientSecret   string   `yaml:"client_secret" env:"CLIENT_SECRET" secret:"true"`
+	AuthURL        string   `yaml:"auth_url" env:"AUTH_URL"`
+	TokenURL       string   `yaml:"token_url" env:"TOKEN_URL"`
+	Scopes         []string `yaml:"scopes" env:"SCOPES"`
+	UserInfoURL    string   `yaml:"userinfo_url" env:"USERINFO_URL"`
+	AccountIDField string   `yaml:"account_id_field" env:"ACCOUNT_ID_FIELD"`
+}
+
+// RateLimit overrides the default rate limits
+type RateLimit struct {
+	Store   string            `yaml:"store" env:"RATE_LIMIT_STORE" usage:"where the buckets are kept: memory or postgres"`
+	Default string            `yaml:"default" env:"RATE_LIMIT_DEFAULT" usage:"limit per caller across all methods, e.g. 600/m"`
+	Methods map[string]string `yaml:"methods" env:"RATE_LIMIT_METHODS" usage:"per-method limits, e.g. CreateInfluencer=100/h,ListPosts=120/m"`
+	Peer    string            `yaml:"peer" env:"RATE_LIMIT_PEER" usage:"limit per client IP before authentication, e.g. 3000/m, or off"`
+}
+
+// Tracing configures exporting OpenTelemetry traces
+type Tracing struct {
+	Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER" usage:"where spans are sent: none, otlp or stdout"`
+	Endpoint    string  `yaml:"endpoint" env:"TRACING_ENDPOINT" usage:"URL of the OTLP gRPC collector, e.g. http://localhost:4317; defaults to OTEL_EXPORTER_OTLP_ENDPOINT"`
+	SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" usage:"fraction of new traces that are recorded, from 0 to 1"`
+}
+
+// Default returns the configuration used for settings that aren't set
+func Default() *Config {
+	return &Config{
+		GRPCAddr: ":50051",
+		HTTPAddr: ":8080",
+		Database: Database{
+			Host:    "localhost",
+			Port:    5432,
+			User:    "postgres",
+			Name:    "socialforge",
+			SSLMode: "disable",
+		},
+		RateLimit: RateLimit{
+			Store: "memory",
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			SampleRatio: 1,
+		},
+		IdempotencyKeyRetention: idempotency.DefaultRetention,
+		TrashRetention:          worker.DefaultTrashRetention,
+	}
+}
+
+// DSN returns the connection string of the database
+func (d Database) DSN() string {
+	if d.URL != "" {
+		return d.URL
+	}
+	params := []struct{ key, value string }{
+		{"host", d.Host},
+		{"port", strconv.Itoa(d.Port)},
+		{"user", d.User},
+		{"password", d.Password},
+		{"dbname", d.Name},
+		{"sslmode", d.SSLMode},
+	}
+	var parts []string
+	for _, p := range params {
+		if p.value != "" {
+			parts = append(parts, p.key+"="+quoteDSN(p.value))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// quoteDSN quotes a connection string value if it needs it
+func quoteDSN(v string) string {
+	if !strings.ContainsAny(v, ` '\`) {
+		return v
+	}
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `'`, `\'`)
+	return "'" + v + "'"
+}
+
+// TLSConfig returns the TLS files, or nil if no certificate is configured
+func (c *Config) TLSConfig() (*tlsconfig.Config, error) {
+	t := c.TLS
+	if t.CertFile == "" && t.KeyFile == "" {
+		if t.ClientCAFile != "" {
+			return nil, fmt.Errorf("tls.client_ca_file requires tls.cert_file and tls.key_file")
+		}
+		return nil, nil
+	}
+	if t.CertFile == "" || t.KeyFile == "" {
+		return nil, fmt.Errorf("both tls.cert_file and tls.key_file are required")
+	}
+
+	cfg := &tlsconfig.Config{
+		CertFile:     t.CertFile,
+		KeyFile:      t.KeyFile,
+		ClientCAFile: t.ClientCAFile,
+	}
+	switch t.ClientAuth {
+	case "", "optional":
+	case "require":
+		if t.ClientCAFile == "" {
+			return nil, fmt.Errorf("tls.client_auth require needs tls.client_ca_file")
+		}
+		cfg.RequireClientCert = true
+	default:
+		return nil, fmt.Errorf("invalid tls.client_auth %q: expected optional or require", t.ClientAuth)
+	}
+	return cfg, nil
+}
+
+// Keyring returns the vault keyring, or nil if no keys are configured
+func (c *Config) Keyring() (*vault.Keyring, error) {
+	if c.Vault.Keys == "" {
+		if c.Vault.ActiveKey != 0 {
+			return nil, fmt.Errorf("vault.active_key requires vault.keys")
+		}
+		return nil, nil
+	}
+	kr, err := vault.ParseKeyring(c.Vault.Keys, c.Vault.ActiveKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vault.keys: %v", err)
+	}
+	return kr, nil
+}
+
+// PageTokenSigner returns the page token signer, or nil if no key is
+// configured
+func (c *Config) PageTokenSigner() (*pagetoken.Signer, error) {
+	if c.PageTokenKey == "" {
+		return nil, nil
+	}
+	s, err := pagetoken.ParseSigner(c.PageTokenKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page_token_key: %v", err)
+	}
+	return s, nil
+}
+
+// ConnectConfig returns the OAuth connect configuration, or nil if no
+// platforms are configured
+func (c *Config) ConnectConfig() (*connect.Config, error) {
+	o := c.OAuth
+	if len(o.Platforms) == 0 {
+		return nil, nil
+	}
+	if o.RedirectURL == "" {
+		return nil, fmt.Errorf("oauth.redirect_url is required")
+	}
+
+	cfg := &connect.Config{
+		RedirectURL:     o.RedirectURL,
+		ReturnURL:       o.ReturnURL,
+		AppRedirectURLs: o.AppRedirectURLs,
+		Providers:       make(map[string]*connect.Provider),
+	}
+	for _, name := range o.Platforms {
+		p := o.Providers[name]
+		if p == nil {
+			return nil, fmt.Errorf("oauth.providers.%s is required", name)
+		}
+		required := []struct{ key, value string }{
+			{"client_id", p.ClientID},
+			{"auth_url", p.AuthURL},
+			{"token_url", p.TokenURL},
+			{"userinfo_url", p.UserInfoURL},
+		}
+		for _, r := range required {
+			if r.value == "" {
+				return nil, fmt.Errorf("oauth.providers.%s.%s is required", name, r.key)
+			}
+		}
+		accountIDField := p.AccountIDField
+		if accountIDField == "" {
+			accountIDField = "id"
+		}
+		cfg.Providers[name] = &connect.Provider{
+			Name:           name,
+			ClientID:       p.ClientID,
+			ClientSecret:   p.ClientSecret,
+			AuthURL:        p.AuthURL,
+			TokenURL:       p.TokenURL,
+			Scopes:         p.Scopes,
+			UserInfoURL:    p.UserInfoURL,
+			AccountIDField: accountIDField,
+		}
+	}
+	return cfg, nil
+}
+
+// RateLimits returns the default rate limits with the configured overrides
+func (c *Config) RateLimits() (*ratelimit.Config, error) {
+	cfg := ratelimit.DefaultConfig()
+	if c.RateLimit.Default != "" {
+		l, err := ratelimit.ParseLimit(c.RateLimit.Default)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate_limit.default: %v", err)
+		}
+		cfg.Default = l
+	}
+	switch c.RateLimit.Peer {
+	case "":
+	case "off":
+		cfg.Peer = ratelimit.Limit{}
+	default:
+		l, err := ratelimit.ParseLimit(c.RateLimit.Peer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate_limit.peer: %v", err)
+		}
+		cfg.Peer = l
+	}
+	for _, method := range sortedKeys(c.RateLimit.Methods) {
+		l, err := ratelimit.ParseLimit(c.RateLimit.Methods[method])
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate_limit.methods entry %s: %v", method, err)
+		}
+		cfg.Methods[method] = l
+	}
+	return cfg, nil
+}
+
+// TrustedProxyRanges returns the address ranges of the trusted proxies. A
+// single address is a range of one.
+func (c *Config) TrustedProxyRanges() ([]netip.Prefix, error) {
+	var ranges []netip.Prefix
+	for _, p := range c.TrustedProxies {
+		if addr, err := netip.ParseAddr(p); err == nil {
+			ranges = append(ranges, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted_proxies entry %q: expected an address or CIDR range", p)
+		}
+		ranges = append(ranges, prefix.Masked())
+	}
+	return ranges, nil
+}
+
+// TracingConfig returns the trace exporter configuration, or nil if traces
+// aren't exported
+func (c *Config) TracingConfig() (*tracing.Config, error) {
+	t := c.Tracing
+	switch t.Exporter {
+	case "", "none":
+		return nil, nil
+	case tracing.ExporterOTLP, tracing.ExporterStdout:
+	default:
+		return nil, fmt.Errorf("invalid tracing.exporter %q: expected none, otlp or stdout", t.Exporter)
+	}
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		return nil, fmt.Errorf("invalid tracing.sample_ratio %v: expected 0 to 1", t.SampleRatio)
+	}
+	if t.Endpoint != "" {
+		u, err := url.Parse(t.Endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid tracing.endpoint %q: expected an http:// or https:// URL", t.Endpoint)
+		}
+	}
+	return &tracing.Config{
+		Exporter:    t.Exporter,
+		Endpoint:    t.Endpoint,
+		SampleRatio: t.SampleRatio,
+	}, nil
+}
@@ -0,0 +1,331 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileEnv names the YAML file to load when --config isn't given
+const FileEnv = "CONFIG_FILE"
+
+// fileSuffix is appended to the environment variable of a secret to read
+// it from a file instead
+const fileSuffix = "_FILE"
+
+// redacted replaces secrets in printed configurations
+const redacted = "REDACTED"
+
+// Flags holds the settings given on the command line
+type Flags struct {
+	file   string
+	values map[string]string
+	order  []string
+}
+
+// RegisterFlags defines --config and a flag for every setting that isn't a
+// secret on fs
+func RegisterFlags(fs *flag.FlagSet) *Flags {
+	f := &Flags{values: make(map[string]string)}
+	fs.StringVar(&f.file, "config", "", "YAML file to load settings from (env "+FileEnv+")")
+	walk(reflect.ValueOf(Default()).Elem(), "", "", func(s setting) error {
+		if s.secret {
+			return nil
+		}
+		key := s.key
+		fs.Func(flagName(key), s.usage+" (env "+s.env+")", func(v string) error {
+			if _, ok := f.values[key]; !ok {
+				f.order = append(f.order, key)
+			}
+			f.values[key] = v
+			return nil
+		})
+		return nil
+	})
+	return f
+}
+
+// Load loads the configuration. Settings are taken, from lowest to highest
+// precedence, from the defaults, the YAML file, the environment and the
+// flags. flags may be nil. The result is validated.
+func Load(flags *Flags) (*Config, error) {
+	cfg := Default()
+
+	path := os.Getenv(FileEnv)
+	if flags != nil && flags.file != "" {
+		path = flags.file
+	}
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+	if flags != nil {
+		if err := cfg.loadFlags(flags); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, name := range cfg.OAuth.Platforms {
+		cfg.OAuth.Platforms[i] = strings.ToLower(name)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Print writes the configuration as YAML with its secrets redacted
+func (c *Config) Print(w io.Writer) error {
+	out, err := c.clone()
+	if err != nil {
+		return err
+	}
+	err = out.walkAll(func(s setting) error {
+		if s.secret && !s.value.IsZero() {
+			s.value.SetString(redacted)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(out); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %v", err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	return nil
+}
+
+func (c *Config) loadEnv() error {
+	err := walk(reflect.ValueOf(c).Elem(), "", "", setFromEnv)
+	if err != nil {
+		return err
+	}
+
+	// Platforms are configured with variables prefixed with their name
+	for _, name := range c.OAuth.Platforms {
+		name = strings.ToLower(name)
+		p := c.OAuth.Providers[name]
+		if p == nil {
+			p = &Provider{}
+		}
+		prefix := "OAUTH_" + strings.ToUpper(name) + "_"
+		if err := walk(reflect.ValueOf(p).Elem(), "oauth.providers."+name, prefix, setFromEnv); err != nil {
+			return err
+		}
+		if c.OAuth.Providers == nil {
+			c.OAuth.Providers = make(map[string]*Provider)
+		}
+		c.OAuth.Providers[name] = p
+	}
+	return nil
+}
+
+func (c *Config) loadFlags(flags *Flags) error {
+	settings := make(map[string]setting)
+	walk(reflect.ValueOf(c).Elem(), "", "", func(s setting) error {
+		settings[s.key] = s
+		return nil
+	})
+	for _, key := range flags.order {
+		if err := set(settings[key].value, flags.values[key]); err != nil {
+			return fmt.Errorf("invalid --%s: %v", flagName(key), err)
+		}
+	}
+	return nil
+}
+
+// clone returns a deep copy of the configuration
+func (c *Config) clone() (*Config, error) {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	out := &Config{}
+	if err := yaml.Unmarshal(data, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// setting is a single value of the configuration
+type setting struct {
+	// key is the dotted path of the setting in the YAML file
+	key    string
+	env    string
+	usage  string
+	secret bool
+	value  reflect.Value
+}
+
+// walk calls fn for each setting of the struct v, descending into nested
+// structs. Maps of structs, like the OAuth providers, are skipped.
+func walk(v reflect.Value, keyPrefix, envPrefix string, fn func(setting) error) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		key := name
+		if keyPrefix != "" {
+			key = keyPrefix + "." + name
+		}
+
+		value := v.Field(i)
+		switch {
+		case value.Kind() == reflect.Struct:
+			if err := walk(value, key, envPrefix, fn); err != nil {
+				return err
+			}
+		case value.Kind() == reflect.Map && value.Type().Elem().Kind() != reflect.String:
+		default:
+			err := fn(setting{
+				key:    key,
+				env:    envPrefix + field.Tag.Get("env"),
+				usage:  field.Tag.Get("usage"),
+				secret: field.Tag.Get("secret") == "true",
+				value:  value,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// walkAll calls fn for each setting, including those of the OAuth
+// providers
+func (c *Config) walkAll(fn func(setting) error) error {
+	if err := walk(reflect.ValueOf(c).Elem(), "", "", fn); err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(c.OAuth.Providers) {
+		p := c.OAuth.Providers[name]
+		if err := walk(reflect.ValueOf(p).Elem(), "oauth.providers."+name, "", fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setFromEnv sets a setting from its environment variable, or for secrets
+// from the file named by the variable with the _FILE suffix
+func setFromEnv(s setting) error {
+	v, ok := os.LookupEnv(s.env)
+	if s.secret {
+		if path := os.Getenv(s.env + fileSuffix); path != "" {
+			if ok {
+				return fmt.Errorf("only one of %s and %s may be set", s.env, s.env+fileSuffix)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %v", s.env+fileSuffix, err)
+			}
+			v, ok = strings.TrimSpace(string(data)), true
+		}
+	}
+	if !ok {
+		return nil
+	}
+	if err := set(s.value, v); err != nil {
+		return fmt.Errorf("invalid %s: %v", s.env, err)
+	}
+	return nil
+}
+
+// set parses s into v. Lists are separated by commas or whitespace and maps
+// are lists of key=value pairs.
+func set(v reflect.Value, s string) error {
+	switch {
+	case v.Type() == reflect.TypeOf(time.Duration(0)):
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("expected a duration like 24h, got %q", s)
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.String:
+		v.SetString(s)
+	case v.Kind() == reflect.Int:
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("expected a number, got %q", s)
+		}
+		v.SetInt(int64(n))
+	case v.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("expected a number, got %q", s)
+		}
+		v.SetFloat(f)
+	case v.Kind() == reflect.Slice:
+		v.Set(reflect.ValueOf(splitList(s)))
+	case v.Kind() == reflect.Map:
+		m := make(map[string]string)
+		for _, entry := range strings.Split(s, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+			key, value, ok := strings.Cut(entry, "=")
+			if !ok {
+				return fmt.Errorf("invalid entry %q: expected key=value", entry)
+			}
+			m[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+		v.Set(reflect.ValueOf(m))
+	default:
+		return fmt.Errorf("unsupported setting type %s", v.Type())
+	}
+	return nil
+}
+
+// flagName is the flag of a setting, e.g. database-host for database.host
+func flagName(key string) string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(key)
+}
+
+// splitList splits a list separated by commas or whitespace
+func splitList(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\t'
+	})
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,130 @@
+package config
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFile writes content to a file in a temporary directory and returns
+// its path
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := writeFile(t, "config.yaml", `
+grpc_addr: ":1"
+http_addr: ":2"
+auth0:
+  domain: example.auth0.com
+database:
+  host: file-host
+  port: 6543
+`)
+	// --config wins over CONFIG_FILE
+	t.Setenv(FileEnv, filepath.Join(t.TempDir(), "missing.yaml"))
+	t.Setenv("HTTP_ADDR", ":3")
+	t.Setenv("DB_HOST", "env-host")
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := RegisterFlags(fs)
+	if err := fs.Parse([]string{"--config", path, "--database-host", "flag-host"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	cfg, err := Load(flags)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{name: "default", got: cfg.Database.Name, want: "socialforge"},
+		{name: "file over default", got: cfg.Database.Port, want: 6543},
+		{name: "file", got: cfg.GRPCAddr, want: ":1"},
+		{name: "env over file", got: cfg.HTTPAddr, want: ":3"},
+		{name: "flag over env", got: cfg.Database.Host, want: "flag-host"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestLoadSecretFiles(t *testing.T) {
+	t.Setenv("AUTH0_DOMAIN", "example.auth0.com")
+	t.Setenv("DB_PASSWORD_FILE", writeFile(t, "password", "s3cret\n"))
+
+	cfg, err := Load(nil)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Database.Password != "s3cret" {
+		t.Errorf("password = %q, want s3cret", cfg.Database.Password)
+	}
+
+	// Settings that aren't secret can't be read from files
+	t.Setenv("DB_HOST_FILE", writeFile(t, "host", "file-host"))
+	cfg, err = Load(nil)
+	if err != nil {
+		t.Fatalf("Load with DB_HOST_FILE: %v", err)
+	}
+	if cfg.Database.Host != "localhost" {
+		t.Errorf("host = %q, want localhost", cfg.Database.Host)
+	}
+
+	t.Setenv("DB_PASSWORD", "other")
+	if _, err := Load(nil); err == nil || !strings.Contains(err.Error(), "only one of DB_PASSWORD and DB_PASSWORD_FILE") {
+		t.Errorf("Load with both DB_PASSWORD and DB_PASSWORD_FILE: %v", err)
+	}
+
+	os.Unsetenv("DB_PASSWORD")
+	t.Setenv("DB_PASSWORD_FILE", filepath.Join(t.TempDir(), "missing"))
+	if _, err := Load(nil); err == nil || !strings.Contains(err.Error(), "failed to read DB_PASSWORD_FILE") {
+		t.Errorf("Load with a missing password file: %v", err)
+	}
+}
+
+func TestPrintRedactsSecrets(t *testing.T) {
+	cfg := Default()
+	cfg.Database.User = "app-user"
+	cfg.Database.Password = "db-password"
+	cfg.Vault.Keys = "1:vault-key"
+	cfg.OAuth.Providers = map[string]*Provider{
+		"mock": {ClientID: "mock-client", ClientSecret: "mock-secret"},
+	}
+
+	var buf bytes.Buffer
+	if err := cfg.Print(&buf); err != nil {
+		t.Fatalf("Print: %v", err)
+	}
+	out := buf.String()
+
+	for _, secret := range []string{"db-password", "vault-key", "mock-secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("printed configuration contains %q:\n%s", secret, out)
+		}
+	}
+	for _, want := range []string{"password: " + redacted, "keys: " + redacted, "client_secret: " + redacted, "user: app-user", "client_id: mock-client", `page_token_key: ""`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("printed configuration lacks %q:\n%s", want, out)
+		}
+	}
+
+	// Printing leaves the configuration itself alone
+	if cfg.Database.Password != "db-password" || cfg.OAuth.Providers["mock"].ClientSecret != "mock-secret" {
+		t.Error("Print changed the configuration")
+	}
+}
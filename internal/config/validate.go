@@ -0,0 +1,101 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// sslModes are the sslmode values Postgres accepts
+var sslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// Validate checks the configuration and returns every problem found
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	check(validateAddr("grpc_addr", c.GRPCAddr))
+	check(validateAddr("http_addr", c.HTTPAddr))
+	check(c.Database.validate())
+
+	switch domain := c.Auth0.Domain; {
+	case domain == "":
+		check(errors.New("auth0.domain is required"))
+	case strings.ContainsAny(domain, "/:"):
+		check(fmt.Errorf("invalid auth0.domain %q: expected a host name without scheme or path", domain))
+	}
+
+	_, err := c.TrustedProxyRanges()
+	check(err)
+	_, err = c.TLSConfig()
+	check(err)
+	_, err = c.Keyring()
+	check(err)
+	_, err = c.PageTokenSigner()
+	check(err)
+	_, err = c.ConnectConfig()
+	check(err)
+	_, err = c.RateLimits()
+	check(err)
+	_, err = c.TracingConfig()
+	check(err)
+
+	switch c.RateLimit.Store {
+	case "memory", "postgres":
+	default:
+		check(fmt.Errorf("invalid rate_limit.store %q: expected memory or postgres", c.RateLimit.Store))
+	}
+	if c.IdempotencyKeyRetention <= 0 {
+		check(errors.New("idempotency_key_retention must be positive"))
+	}
+	if c.TrashRetention <= 0 {
+		check(errors.New("trash_retention must be positive"))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func (d Database) validate() error {
+	if d.URL != "" {
+		u, err := url.Parse(d.URL)
+		if err != nil || (u.Scheme != "postgres" && u.Scheme != "postgresql") {
+			return errors.New("invalid database.url: expected a postgres:// URL")
+		}
+		return nil
+	}
+
+	var errs []error
+	if d.Host == "" {
+		errs = append(errs, errors.New("database.host is required"))
+	}
+	if d.Port < 1 || d.Port > 65535 {
+		errs = append(errs, fmt.Errorf("invalid database.port %d: expected 1 to 65535", d.Port))
+	}
+	if d.User == "" {
+		errs = append(errs, errors.New("database.user is required"))
+	}
+	if d.Name == "" {
+		errs = append(errs, errors.New("database.name is required"))
+	}
+	if !slices.Contains(sslModes, d.SSLMode) {
+		errs = append(errs, fmt.Errorf("invalid database.sslmode %q: expected one of %s", d.SSLMode, strings.Join(sslModes, ", ")))
+	}
+	return errors.Join(errs...)
+}
+
+func validateAddr(key, addr string) error {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return fmt.Errorf("invalid %s %q: expected host:port or :port", key, addr)
+	}
+	return nil
+}
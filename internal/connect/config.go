@@ -0,0 +1,83 @@
+package connect
+
+import (
+	"net"
+	"net/url"
+
+	"golang.org/x/oauth2"
+)
+
+// CallbackPath is the path the callback handler is served on. The redirect
+// URL registered with each platform must point to it.
+const CallbackPath = "/oauth/callback"
+
+// Provider is the OAuth2 configuration of one platform
+type Provider struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	AuthURL      string
+	TokenURL     string
+	Scopes       []string
+	// UserInfoURL returns the authorized account as JSON
+	UserInfoURL string
+	// AccountIDField is the dotted path of the account ID in the user info
+	// response, e.g. "id" or "data.id"
+	AccountIDField string
+}
+
+// Config holds the providers and URLs of the connect flow
+type Config struct {
+	// RedirectURL is the public URL of the callback handler
+	RedirectURL string
+	// ReturnURL is where the browser is sent after the callback. If it is
+	// empty the callback answers with a plain text page.
+	ReturnURL string
+	// AppRedirectURLs are the redirect URLs native apps may receive the
+	// redirect at instead of the callback handler, e.g. a loopback address
+	// or a private-use URI scheme (RFC 8252). They have to be registered
+	// with the platforms too.
+	AppRedirectURLs []string
+	// Providers are keyed by the lowercase platform name
+	Providers map[string]*Provider
+}
+
+// appRedirectAllowed reports whether an app may use redirectURL. Loopback
+// addresses match with any port, since apps listen on whatever port is
+// free.
+func (c *Config) appRedirectAllowed(redirectURL string) bool {
+	u, err := url.Parse(redirectURL)
+	if err != nil || u.Fragment != "" {
+		return false
+	}
+	for _, allowed := range c.AppRedirectURLs {
+		if redirectURL == allowed {
+			return true
+		}
+		a, err := url.Parse(allowed)
+		if err != nil || a.Scheme != "http" || u.Scheme != "http" {
+			continue
+		}
+		if ip := net.ParseIP(a.Hostname()); ip == nil || !ip.IsLoopback() {
+			continue
+		}
+		if a.Hostname() == u.Hostname() && a.Path == u.Path && a.RawQuery == u.RawQuery {
+			return true
+		}
+	}
+	return false
+}
+
+// oauth2Config returns the oauth2 configuration of the provider
+func (p *Provider) oauth2Config(redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.ClientID,
+		ClientSecret: p.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  p.AuthURL,
+			TokenURL: p.TokenURL,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      p.Scopes,
+	}
+}
@@ -0,0 +1,501 @@
+package connect
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/connectsession"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/vault"
+)
+
+// sessionTTL is how long the user has to complete the authorization
+const sessionTTL = 10 * time.Minute
+
+var (
+	// ErrUnknownPlatform is returned for platforms without a provider
+	ErrUnknownPlatform = errors.New("platform is not configured for account connect")
+	// ErrInvalidState is returned when the callback state is unknown,
+	// already used or expired
+	ErrInvalidState = errors.New("connect session is invalid or expired")
+	// ErrAccountMismatch is returned when a verified influencer is
+	// reconnected with a different platform account
+	ErrAccountMismatch = errors.New("authorized account does not match the connected account")
+	// ErrAccountConnected is returned when the authorized account already
+	// belongs to an influencer in another organization
+	ErrAccountConnected = errors.New("account is already connected to another influencer")
+	// ErrRedirectURL is returned when an app asks for a redirect URL that
+	// isn't configured
+	ErrRedirectURL = errors.New("redirect URL is not allowed for account connect")
+	// ErrProvider is returned when the platform rejects the code exchange
+	// or the account lookup
+	ErrProvider = errors.New("platform authorization failed")
+)
+
+// StartRequest describes who starts a connect flow and for what
+type StartRequest struct {
+	Platform       string
+	UserID         string
+	OrganizationID string
+	// InfluencerID is set to reconnect an existing influencer
+	InfluencerID string
+	// Name is used for the influencer created when the flow completes
+	Name string
+	// RedirectURL is set by native apps that receive the redirect
+	// themselves and complete the flow with CompleteApp
+	RedirectURL string
+}
+
+// Flow implements the OAuth2 authorization-code flow with PKCE used to
+// connect influencer accounts. The account ID is taken from the platform
+// after the code exchange, never from the caller, and the tokens go
+// straight into the credential vault.
+type Flow struct {
+	client      *ent.Client
+	credentials *vault.Store
+	config      *Config
+	httpClient  *http.Client
+}
+
+// Option configures optional behaviour of the Flow
+type Option func(*Flow)
+
+// WithHTTPClient sets the HTTP client used to talk to the platforms
+func WithHTTPClient(c *http.Client) Option {
+	return func(f *Flow) {
+		f.httpClient = c
+	}
+}
+
+// NewFlow creates a new connect flow
+func NewFlow(client *ent.Client, credentials *vault.Store, config *Config, opts ...Option) *Flow {
+	f := &Flow{
+		client:      client,
+		credentials: credentials,
+		config:      config,
+		httpClient:  http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Authorization is a started connect flow
+type Authorization struct {
+	// URL is where the user has to authorize the account
+	URL string
+	// ExpiresAt is when the session expires
+	ExpiresAt time.Time
+	// Cookie binds the session to the browser that started it. It has to
+	// be set in that browser, or the callback rejects the session.
+	Cookie *http.Cookie
+}
+
+// Start creates a connect session and returns how the user authorizes
+// the account
+func (f *Flow) Start(ctx context.Context, req StartRequest) (*Authorization, error) {
+	p, ok := f.config.Providers[req.Platform]
+	if !ok {
+		return nil, ErrUnknownPlatform
+	}
+	if req.RedirectURL != "" && !f.config.appRedirectAllowed(req.RedirectURL) {
+		return nil, ErrRedirectURL
+	}
+
+	// Clean up sessions that were never completed
+	if _, err := f.client.ConnectSession.Delete().
+		Where(connectsession.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete expired sessions: %v", err)
+	}
+
+	state, err := randomState()
+	if err != nil {
+		return nil, err
+	}
+	verifier := oauth2.GenerateVerifier()
+	expiresAt := time.Now().Add(sessionTTL)
+
+	create := f.client.ConnectSession.Create().
+		SetID(uuid.New().String()).
+		SetState(state).
+		SetCodeVerifier(verifier).
+		SetPlatform(req.Platform).
+		SetUserID(req.UserID).
+		SetOrganizationID(req.OrganizationID).
+		SetExpiresAt(expiresAt)
+	if req.InfluencerID != "" {
+		create.SetInfluencerID(req.InfluencerID)
+	}
+	if req.Name != "" {
+		create.SetName(req.Name)
+	}
+	if req.RedirectURL != "" {
+		create.SetRedirectURL(req.RedirectURL)
+	}
+	if err := create.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to create connect session: %v", err)
+	}
+
+	// An app completes the flow itself, authenticated as the user who
+	// started it, so there is no browser to bind the session to
+	authorization := &Authorization{
+		URL:       p.oauth2Config(f.redirectURL(req.RedirectURL)).AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)),
+		ExpiresAt: expiresAt,
+	}
+	if req.RedirectURL == "" {
+		authorization.Cookie = f.cookie(stateHash(state), int(sessionTTL.Seconds()))
+	}
+	return authorization, nil
+}
+
+// Complete exchanges the authorization code of the session identified by
+// state, binds the verified account to an influencer and stores the tokens.
+// It completes the sessions that redirect to the callback handler.
+func (f *Flow) Complete(ctx context.Context, state, code string) (*ent.Influencer, error) {
+	session, err := f.consume(ctx, state, func(s *ent.ConnectSession) bool {
+		return s.RedirectURL == ""
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f.complete(ctx, session, code)
+}
+
+// CompleteApp is Complete for the sessions an app started with its own
+// redirect URL. Only the user who started the session can complete it.
+func (f *Flow) CompleteApp(ctx context.Context, userID, state, code string) (*ent.Influencer, error) {
+	session, err := f.consume(ctx, state, func(s *ent.ConnectSession) bool {
+		return s.RedirectURL != "" && s.UserID == userID
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f.complete(ctx, session, code)
+}
+
+func (f *Flow) complete(ctx context.Context, session *ent.ConnectSession, code string) (*ent.Influencer, error) {
+	p, ok := f.config.Providers[session.Platform]
+	if !ok {
+		return nil, ErrUnknownPlatform
+	}
+
+	// Exchange the code and look up which account was authorized. The
+	// redirect URL has to be the one the authorization was requested with.
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, f.httpClient)
+	cfg := p.oauth2Config(f.redirectURL(session.RedirectURL))
+	token, err := cfg.Exchange(ctx, code, oauth2.VerifierOption(session.CodeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to exchange code: %v", ErrProvider, err)
+	}
+	accountID, err := p.accountID(ctx, cfg.Client(ctx, token))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProvider, err)
+	}
+
+	// Attribute the changes to the user who started the flow
+	u, err := f.client.User.Get(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %v", err)
+	}
+	ctx = auth.NewContext(ctx, &auth.Principal{
+		Kind:    auth.PrincipalUser,
+		Subject: u.Auth0ID,
+		Email:   u.Email,
+		Name:    u.Name,
+		UserID:  u.ID,
+	})
+
+	inf, err := f.bind(ctx, session, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &vault.Secret{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    token.TokenType,
+	}
+	if !token.Expiry.IsZero() {
+		secret.Expiry = token.Expiry.UTC().Truncate(time.Second)
+	}
+	if err := f.credentials.Put(ctx, inf.ID, secret); err != nil {
+		return nil, fmt.Errorf("failed to store credentials: %v", err)
+	}
+	return inf, nil
+}
+
+// redirectURL returns the redirect URL of a session: the app's, or the
+// callback handler's if the app didn't give one
+func (f *Flow) redirectURL(appRedirectURL string) string {
+	if appRedirectURL != "" {
+		return appRedirectURL
+	}
+	return f.config.RedirectURL
+}
+
+// consume loads the session for state and deletes it, so that every
+// session can be completed at most once. A session that doesn't match is
+// left alone and reported as invalid.
+func (f *Flow) consume(ctx context.Context, state string, match func(*ent.ConnectSession) bool) (*ent.ConnectSession, error) {
+	if state == "" {
+		return nil, ErrInvalidState
+	}
+	session, err := f.client.ConnectSession.Query().
+		Where(connectsession.State(state)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidState
+		}
+		return nil, fmt.Errorf("failed to get connect session: %v", err)
+	}
+	if !match(session) {
+		return nil, ErrInvalidState
+	}
+
+	n, err := f.client.ConnectSession.Delete().
+		Where(connectsession.ID(session.ID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete connect session: %v", err)
+	}
+	if n == 0 || time.Now().After(session.ExpiresAt) {
+		return nil, ErrInvalidState
+	}
+	return session, nil
+}
+
+// bind records the verified account on the influencer being reconnected, or
+// on the influencer for that account in the session's organization,
+// creating it if there is none
+func (f *Flow) bind(ctx context.Context, session *ent.ConnectSession, accountID string) (*ent.Influencer, error) {
+	now := time.Now()
+
+	holder, err := f.client.Influencer.Query().
+		Where(
+			influencer.Platform(session.Platform),
+			influencer.AccountID(accountID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get influencer: %v", err)
+	}
+
+	// Only a verified account is kept from another organization; one that
+	// was entered by hand gives way
+	if holder != nil && holder.OrganizationID != session.OrganizationID {
+		if holder.AccountVerifiedAt != nil {
+			return nil, ErrAccountConnected
+		}
+		if err := f.release(ctx, holder); err != nil {
+			return nil, err
+		}
+		holder = nil
+	}
+
+	if session.InfluencerID != "" {
+		inf, err := f.client.Influencer.Get(ctx, session.InfluencerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get influencer: %v", err)
+		}
+		if inf.AccountVerifiedAt != nil && inf.AccountID != accountID {
+			return nil, ErrAccountMismatch
+		}
+		return f.verify(ctx, inf, accountID, now)
+	}
+	if holder != nil {
+		return f.verify(ctx, holder, accountID, now)
+	}
+
+	name := session.Name
+	if name == "" {
+		name = accountID
+	}
+	inf, err := f.client.Influencer.Create().
+		SetID(uuid.New().String()).
+		SetName(name).
+		SetPlatform(session.Platform).
+		SetAccountID(accountID).
+		SetAccountVerifiedAt(now).
+		SetOwnerID(session.UserID).
+		SetOrganizationID(session.OrganizationID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrAccountConnected
+		}
+		return nil, fmt.Errorf("failed to create influencer: %v", err)
+	}
+	return inf, nil
+}
+
+// release takes the account off an influencer that claimed it without
+// verifying it. The influencer is disconnected until it connects an
+// account of its own: its scheduled posts are paused and its credentials,
+// which can't be for an account it proved, are deleted.
+func (f *Flow) release(ctx context.Context, inf *ent.Influencer) error {
+	tx, err := f.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.Influencer.UpdateOne(inf).
+		ClearAccountID().
+		SetStatus("disconnected").
+		SetDisconnectReason("the account was connected by another organization").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release account: %v", err)
+	}
+	err = tx.Post.Update().
+		Where(
+			post.InfluencerID(inf.ID),
+			post.Status("scheduled"),
+		).
+		SetStatus("paused").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to pause posts: %v", err)
+	}
+	if _, err := tx.Credential.Delete().
+		Where(credential.InfluencerID(inf.ID)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete credentials: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to release account: %v", err)
+	}
+	return nil
+}
+
+// verify marks the influencer's account as verified and active again, and
+// resumes the posts paused when it was disconnected
+func (f *Flow) verify(ctx context.Context, inf *ent.Influencer, accountID string, now time.Time) (*ent.Influencer, error) {
+	tx, err := f.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	updated, err := tx.Influencer.UpdateOneID(inf.ID).
+		SetAccountID(accountID).
+		SetAccountVerifiedAt(now).
+		SetStatus("active").
+		ClearDisconnectReason().
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrAccountConnected
+		}
+		return nil, fmt.Errorf("failed to update influencer: %v", err)
+	}
+	if inf.Status == "disconnected" {
+		if err := ResumePosts(ctx, tx, inf.ID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to verify account: %v", err)
+	}
+	return updated.Unwrap(), nil
+}
+
+// ResumePosts reschedules the posts of an influencer that were paused when
+// it was disconnected, as part of reconnecting it in tx. Posts whose time
+// passed meanwhile come back as drafts, like restored ones, rather than
+// being published late.
+func ResumePosts(ctx context.Context, tx *ent.Tx, influencerID string, now time.Time) error {
+	err := tx.Post.Update().
+		Where(
+			post.InfluencerID(influencerID),
+			post.Status("paused"),
+			post.ScheduledTimeLT(now),
+		).
+		SetStatus("draft").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to resume posts: %v", err)
+	}
+	err = tx.Post.Update().
+		Where(
+			post.InfluencerID(influencerID),
+			post.Status("paused"),
+		).
+		SetStatus("scheduled").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to resume posts: %v", err)
+	}
+	return nil
+}
+
+// accountID fetches the user info of the authorized account and returns
+// the value at AccountIDField
+func (p *Provider) accountID(ctx context.Context, client *http.Client) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.UserInfoURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user info: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", fmt.Errorf("user info returned %s: %s", resp.Status, body)
+	}
+
+	dec := json.NewDecoder(io.LimitReader(resp.Body, 1<<20))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", fmt.Errorf("failed to decode user info: %v", err)
+	}
+	for _, key := range strings.Split(p.AccountIDField, ".") {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("user info has no field %q", p.AccountIDField)
+		}
+		v = obj[key]
+	}
+
+	switch id := v.(type) {
+	case string:
+		if id != "" {
+			return id, nil
+		}
+	case json.Number:
+		return id.String(), nil
+	}
+	return "", fmt.Errorf("user info has no account ID in %q", p.AccountIDField)
+}
+
+func randomState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate state: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,525 @@
+package connect
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/vault"
+)
+
+// platform is a mock OAuth2 authorization server with a user info
+// endpoint. It grants access to account, and checks the PKCE verifier
+// when the code is exchanged.
+type platform struct {
+	*httptest.Server
+	account string
+
+	mu         sync.Mutex
+	challenges map[string]string
+}
+
+func newPlatform(t *testing.T, account string) *platform {
+	p := &platform{account: account, challenges: make(map[string]string)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("code_challenge_method") != "S256" {
+			http.Error(w, "PKCE is required", http.StatusBadRequest)
+			return
+		}
+		p.mu.Lock()
+		code := fmt.Sprintf("code-%d", len(p.challenges))
+		p.challenges[code] = q.Get("code_challenge")
+		p.mu.Unlock()
+		redirect := q.Get("redirect_uri") + "?" + url.Values{"code": {code}, "state": {q.Get("state")}}.Encode()
+		http.Redirect(w, r, redirect, http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		p.mu.Lock()
+		challenge, ok := p.challenges[r.Form.Get("code")]
+		delete(p.challenges, r.Form.Get("code"))
+		p.mu.Unlock()
+		sum := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"invalid_grant"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access-" + p.account,
+			"refresh_token": "refresh-" + p.account,
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+	})
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-"+p.account {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": p.account}})
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+// testEnv is a connect flow for the "mock" platform, with its callback
+// served over HTTP
+type testEnv struct {
+	client      *ent.Client
+	credentials *vault.Store
+	flow        *Flow
+	platform    *platform
+	callback    *httptest.Server
+	user        *ent.User
+	org         *ent.Organization
+}
+
+func newTestEnv(t *testing.T, account string) *testEnv {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	keyring, err := vault.ParseKeyring("1:"+base64.StdEncoding.EncodeToString(make([]byte, 32)), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := &testEnv{
+		client:      client,
+		credentials: vault.NewStore(client, keyring),
+		platform:    newPlatform(t, account),
+	}
+	e.callback = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e.flow.CallbackHandler().ServeHTTP(w, r)
+	}))
+	t.Cleanup(e.callback.Close)
+
+	e.flow = NewFlow(client, e.credentials, &Config{
+		RedirectURL:     e.callback.URL + CallbackPath,
+		AppRedirectURLs: []string{"http://127.0.0.1/callback", "com.example.app:/oauth"},
+		Providers: map[string]*Provider{
+			"mock": {
+				Name:           "mock",
+				ClientID:       "client",
+				ClientSecret:   "secret",
+				AuthURL:        e.platform.URL + "/authorize",
+				TokenURL:       e.platform.URL + "/token",
+				UserInfoURL:    e.platform.URL + "/me",
+				AccountIDField: "data.id",
+			},
+		},
+	})
+
+	e.user = client.User.Create().SetID("user-1").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	e.org = client.Organization.Create().SetID("org-1").SetName("Alice's").SaveX(ctx)
+	return e
+}
+
+// start starts a flow for the user
+func (e *testEnv) start(t *testing.T, influencerID string) *Authorization {
+	t.Helper()
+	authorization, err := e.flow.Start(context.Background(), StartRequest{
+		Platform:       "mock",
+		UserID:         e.user.ID,
+		OrganizationID: e.org.ID,
+		InfluencerID:   influencerID,
+		Name:           "Alice on Mock",
+	})
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	return authorization
+}
+
+// authorize has the user grant access on the platform and returns the
+// callback URL the platform redirects to
+func (e *testEnv) authorize(t *testing.T, authorization *Authorization) string {
+	t.Helper()
+	noRedirect := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := noRedirect.Get(authorization.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize returned %s", resp.Status)
+	}
+	return resp.Header.Get("Location")
+}
+
+// complete calls the callback, with the session cookie if it is set
+func (e *testEnv) complete(t *testing.T, callbackURL string, cookie *http.Cookie) (int, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, callbackURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, strings.TrimSpace(string(body))
+}
+
+func TestConnect(t *testing.T) {
+	e := newTestEnv(t, "acct-1")
+	ctx := context.Background()
+
+	authorization := e.start(t, "")
+	if got := authorization.Cookie; got.Path != CallbackPath || !got.HttpOnly || got.SameSite != http.SameSiteLaxMode {
+		t.Errorf("cookie = %v, want HttpOnly, SameSite=Lax on %s", got, CallbackPath)
+	}
+	code, body := e.complete(t, e.authorize(t, authorization), authorization.Cookie)
+	if code != http.StatusOK {
+		t.Fatalf("callback returned %d: %s", code, body)
+	}
+
+	inf, err := e.client.Influencer.Query().Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inf.AccountID != "acct-1" || inf.AccountVerifiedAt == nil {
+		t.Errorf("account = %q verified at %v, want acct-1 verified", inf.AccountID, inf.AccountVerifiedAt)
+	}
+	if inf.Name != "Alice on Mock" || inf.OrganizationID != e.org.ID {
+		t.Errorf("influencer = %q in %q, want Alice on Mock in %q", inf.Name, inf.OrganizationID, e.org.ID)
+	}
+
+	secret, err := e.credentials.Open(ctx, inf.ID)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if secret.AccessToken != "access-acct-1" || secret.RefreshToken != "refresh-acct-1" || secret.Expiry.IsZero() {
+		t.Errorf("secret = %+v, want the platform's tokens", secret)
+	}
+}
+
+func TestConnectApp(t *testing.T) {
+	e := newTestEnv(t, "acct-1")
+	ctx := context.Background()
+
+	start := StartRequest{
+		Platform:       "mock",
+		UserID:         e.user.ID,
+		OrganizationID: e.org.ID,
+		RedirectURL:    "https://attacker.example/callback",
+	}
+	if _, err := e.flow.Start(ctx, start); !errors.Is(err, ErrRedirectURL) {
+		t.Fatalf("Start with another redirect URL: %v, want %v", err, ErrRedirectURL)
+	}
+
+	start.RedirectURL = "http://127.0.0.1:54321/callback"
+	authorization, err := e.flow.Start(ctx, start)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if authorization.Cookie != nil {
+		t.Errorf("cookie = %v, want none for an app", authorization.Cookie)
+	}
+	redirect, err := url.Parse(e.authorize(t, authorization))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := redirect.Scheme + "://" + redirect.Host + redirect.Path; got != start.RedirectURL {
+		t.Fatalf("redirected to %s, want %s", got, start.RedirectURL)
+	}
+	state, code := redirect.Query().Get("state"), redirect.Query().Get("code")
+
+	// Neither the callback nor another user can complete the session, and
+	// trying doesn't use it up
+	if _, err := e.flow.Complete(ctx, state, code); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("Complete: %v, want %v", err, ErrInvalidState)
+	}
+	if _, err := e.flow.CompleteApp(ctx, "user-2", state, code); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("CompleteApp as another user: %v, want %v", err, ErrInvalidState)
+	}
+
+	inf, err := e.flow.CompleteApp(ctx, e.user.ID, state, code)
+	if err != nil {
+		t.Fatalf("CompleteApp: %v", err)
+	}
+	if inf.AccountID != "acct-1" || inf.AccountVerifiedAt == nil {
+		t.Errorf("account = %q verified at %v, want acct-1 verified", inf.AccountID, inf.AccountVerifiedAt)
+	}
+	if _, err := e.credentials.Open(ctx, inf.ID); err != nil {
+		t.Errorf("Open: %v", err)
+	}
+}
+
+func TestConnectBrowserSessionNotCompletedByApp(t *testing.T) {
+	e := newTestEnv(t, "acct-1")
+
+	authorization := e.start(t, "")
+	callbackURL, err := url.Parse(e.authorize(t, authorization))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := callbackURL.Query()
+	if _, err := e.flow.CompleteApp(context.Background(), e.user.ID, q.Get("state"), q.Get("code")); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("CompleteApp: %v, want %v", err, ErrInvalidState)
+	}
+	if code, body := e.complete(t, callbackURL.String(), authorization.Cookie); code != http.StatusOK {
+		t.Errorf("callback returned %d: %s", code, body)
+	}
+}
+
+func TestAppRedirectAllowed(t *testing.T) {
+	c := &Config{AppRedirectURLs: []string{"http://127.0.0.1/callback", "http://[::1]/callback", "com.example.app:/oauth"}}
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{url: "http://127.0.0.1/callback", want: true},
+		{url: "http://127.0.0.1:54321/callback", want: true},
+		{url: "http://[::1]:8000/callback", want: true},
+		{url: "com.example.app:/oauth", want: true},
+		{url: "http://127.0.0.1:54321/other", want: false},
+		{url: "http://localhost:54321/callback", want: false},
+		{url: "https://127.0.0.1/callback", want: false},
+		{url: "http://127.0.0.1/callback#fragment", want: false},
+		{url: "com.example.other:/oauth", want: false},
+	}
+	for _, tt := range tests {
+		if got := c.appRedirectAllowed(tt.url); got != tt.want {
+			t.Errorf("appRedirectAllowed(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestConnectStateReuse(t *testing.T) {
+	e := newTestEnv(t, "acct-1")
+
+	authorization := e.start(t, "")
+	callbackURL := e.authorize(t, authorization)
+	if code, body := e.complete(t, callbackURL, authorization.Cookie); code != http.StatusOK {
+		t.Fatalf("callback returned %d: %s", code, body)
+	}
+
+	code, body := e.complete(t, callbackURL, authorization.Cookie)
+	if code != http.StatusBadRequest || body != ErrInvalidState.Error() {
+		t.Errorf("reused state: %d %q, want 400 %q", code, body, ErrInvalidState)
+	}
+}
+
+func TestConnectExpiredSession(t *testing.T) {
+	e := newTestEnv(t, "acct-1")
+	ctx := context.Background()
+
+	authorization := e.start(t, "")
+	// Sessions can't be changed, so replace it with one that has expired
+	session := e.client.ConnectSession.Query().OnlyX(ctx)
+	e.client.ConnectSession.DeleteOne(session).ExecX(ctx)
+	e.client.ConnectSession.Create().
+		SetID(session.ID).
+		SetState(session.State).
+		SetCodeVerifier(session.CodeVerifier).
+		SetPlatform(session.Platform).
+		SetUserID(session.UserID).
+		SetOrganizationID(session.OrganizationID).
+		SetExpiresAt(time.Now().Add(-time.Second)).
+		ExecX(ctx)
+
+	code, body := e.complete(t, e.authorize(t, authorization), authorization.Cookie)
+	if code != http.StatusBadRequest || body != ErrInvalidState.Error() {
+		t.Errorf("expired session: %d %q, want 400 %q", code, body, ErrInvalidState)
+	}
+	if n := e.client.Influencer.Query().CountX(ctx); n != 0 {
+		t.Errorf("got %d influencers, want none", n)
+	}
+}
+
+func TestConnectOtherBrowser(t *testing.T) {
+	e := newTestEnv(t, "acct-1")
+	ctx := context.Background()
+
+	authorization := e.start(t, "")
+	callbackURL := e.authorize(t, authorization)
+
+	forged := *authorization.Cookie
+	forged.Value = stateHash("another state")
+	for name, cookie := range map[string]*http.Cookie{"no cookie": nil, "other session's cookie": &forged} {
+		code, body := e.complete(t, callbackURL, cookie)
+		if code != http.StatusForbidden || body != ErrOtherBrowser.Error() {
+			t.Errorf("%s: %d %q, want 403 %q", name, code, body, ErrOtherBrowser)
+		}
+	}
+	if n := e.client.Influencer.Query().CountX(ctx); n != 0 {
+		t.Errorf("got %d influencers, want none", n)
+	}
+
+	// The session is still usable by the browser that started it
+	if code, body := e.complete(t, callbackURL, authorization.Cookie); code != http.StatusOK {
+		t.Errorf("callback returned %d: %s", code, body)
+	}
+}
+
+func TestConnectAccountMismatch(t *testing.T) {
+	e := newTestEnv(t, "acct-2")
+	ctx := context.Background()
+
+	inf := e.client.Influencer.Create().
+		SetID("inf-1").
+		SetName("Alice on Mock").
+		SetPlatform("mock").
+		SetAccountID("acct-1").
+		SetAccountVerifiedAt(time.Now()).
+		SetOwner(e.user).
+		SetOrganization(e.org).
+		SaveX(ctx)
+
+	authorization := e.start(t, inf.ID)
+	code, body := e.complete(t, e.authorize(t, authorization), authorization.Cookie)
+	if code != http.StatusConflict || body != ErrAccountMismatch.Error() {
+		t.Errorf("reconnect with another account: %d %q, want 409 %q", code, body, ErrAccountMismatch)
+	}
+	if got := e.client.Influencer.GetX(ctx, inf.ID); got.AccountID != "acct-1" {
+		t.Errorf("account = %q, want acct-1", got.AccountID)
+	}
+	if _, err := e.credentials.Open(ctx, inf.ID); err == nil {
+		t.Error("credentials were stored")
+	}
+}
+
+func TestConnectTakesOverUnverifiedAccount(t *testing.T) {
+	e := newTestEnv(t, "acct-1")
+	ctx := context.Background()
+
+	other := e.client.User.Create().SetID("user-2").SetName("Mallory").SetAuth0ID("auth0|mallory").SaveX(ctx)
+	otherOrg := e.client.Organization.Create().SetID("org-2").SetName("Mallory's").SaveX(ctx)
+	claimed := e.client.Influencer.Create().
+		SetID("inf-claimed").
+		SetName("Not Alice").
+		SetPlatform("mock").
+		SetAccountID("acct-1").
+		SetOwner(other).
+		SetOrganization(otherOrg).
+		SaveX(ctx)
+	p := e.client.Post.Create().
+		SetID("post-1").
+		SetContent("hello").
+		SetScheduledTime(time.Now().Add(time.Hour)).
+		SetInfluencer(claimed).
+		SaveX(ctx)
+
+	authorization := e.start(t, "")
+	if code, body := e.complete(t, e.authorize(t, authorization), authorization.Cookie); code != http.StatusOK {
+		t.Fatalf("callback returned %d: %s", code, body)
+	}
+
+	claimed = e.client.Influencer.GetX(ctx, claimed.ID)
+	if claimed.AccountID != "" || claimed.Status != "disconnected" {
+		t.Errorf("claiming influencer has account %q and status %q, want none and disconnected", claimed.AccountID, claimed.Status)
+	}
+	if got := e.client.Post.GetX(ctx, p.ID); got.Status != "paused" {
+		t.Errorf("post status = %q, want paused", got.Status)
+	}
+	connected, err := e.client.Influencer.Query().Where(influencer.AccountID("acct-1")).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if connected.OrganizationID != e.org.ID || connected.AccountVerifiedAt == nil {
+		t.Errorf("account is in %q verified at %v, want %q verified", connected.OrganizationID, connected.AccountVerifiedAt, e.org.ID)
+	}
+}
+
+func TestConnectVerifiedAccountInOtherOrganization(t *testing.T) {
+	e := newTestEnv(t, "acct-1")
+	ctx := context.Background()
+
+	other := e.client.User.Create().SetID("user-2").SetName("Bob").SetAuth0ID("auth0|bob").SaveX(ctx)
+	otherOrg := e.client.Organization.Create().SetID("org-2").SetName("Bob's").SaveX(ctx)
+	e.client.Influencer.Create().
+		SetID("inf-verified").
+		SetName("Bob on Mock").
+		SetPlatform("mock").
+		SetAccountID("acct-1").
+		SetAccountVerifiedAt(time.Now()).
+		SetOwner(other).
+		SetOrganization(otherOrg).
+		SaveX(ctx)
+
+	authorization := e.start(t, "")
+	code, body := e.complete(t, e.authorize(t, authorization), authorization.Cookie)
+	if code != http.StatusConflict || body != ErrAccountConnected.Error() {
+		t.Errorf("connect verified account: %d %q, want 409 %q", code, body, ErrAccountConnected)
+	}
+}
+
+func TestReconnectResumesPosts(t *testing.T) {
+	e := newTestEnv(t, "acct-1")
+	ctx := context.Background()
+
+	inf := e.client.Influencer.Create().
+		SetID("inf-1").
+		SetName("Alice on Mock").
+		SetPlatform("mock").
+		SetAccountID("acct-1").
+		SetAccountVerifiedAt(time.Now()).
+		SetStatus("disconnected").
+		SetDisconnectReason("refresh rejected").
+		SetOwner(e.user).
+		SetOrganization(e.org).
+		SaveX(ctx)
+	due := e.client.Post.Create().
+		SetID("post-due").
+		SetContent("missed").
+		SetScheduledTime(time.Now().Add(-time.Hour)).
+		SetStatus("paused").
+		SetInfluencer(inf).
+		SaveX(ctx)
+	upcoming := e.client.Post.Create().
+		SetID("post-upcoming").
+		SetContent("later").
+		SetScheduledTime(time.Now().Add(time.Hour)).
+		SetStatus("paused").
+		SetInfluencer(inf).
+		SaveX(ctx)
+
+	authorization := e.start(t, inf.ID)
+	if code, body := e.complete(t, e.authorize(t, authorization), authorization.Cookie); code != http.StatusOK {
+		t.Fatalf("callback returned %d: %s", code, body)
+	}
+
+	if got := e.client.Influencer.GetX(ctx, inf.ID); got.Status != "active" {
+		t.Errorf("influencer status = %q, want active", got.Status)
+	}
+	for _, tt := range []struct {
+		post *ent.Post
+		want string
+	}{
+		{due, "draft"},
+		{upcoming, "scheduled"},
+	} {
+		if got := e.client.Post.GetX(ctx, tt.post.ID); got.Status != tt.want {
+			t.Errorf("post %s status = %q, want %q", tt.post.ID, got.Status, tt.want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package connect
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+)
+
+// SetCookieHeader is the response metadata ConnectInfluencer returns the
+// session cookie in. The HTTP gateway passes it on as a Set-Cookie header.
+const SetCookieHeader = "set-cookie"
+
+// cookieName is the cookie binding a connect session to the browser that
+// started it. A browser can only have one session in progress; starting
+// another replaces the cookie.
+const cookieName = "socialforge_connect"
+
+// ErrOtherBrowser is returned when the callback comes from a browser that
+// didn't start the session, which is how a user would be tricked into
+// connecting their account to someone else's organization
+var ErrOtherBrowser = errors.New("connect session was started in another browser")
+
+// CallbackHandler returns the HTTP handler the platforms redirect to after
+// the user authorized (or denied) access
+func (f *Flow) CallbackHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		q := r.URL.Query()
+
+		// The session is only completed in the browser that started it
+		if !startedHere(r, q.Get("state")) {
+			f.respond(w, r, http.StatusForbidden, "", ErrOtherBrowser.Error())
+			return
+		}
+		http.SetCookie(w, f.cookie("", -1))
+
+		// The user denied access or the platform reported an error; the
+		// session can't be used anymore
+		if e := q.Get("error"); e != "" {
+			_, err := f.consume(r.Context(), q.Get("state"), func(s *ent.ConnectSession) bool {
+				return s.RedirectURL == ""
+			})
+			if err != nil && !errors.Is(err, ErrInvalidState) {
+				log.Printf("connect: %v", err)
+			}
+			f.respond(w, r, http.StatusBadRequest, "", fmt.Sprintf("authorization failed: %s", e))
+			return
+		}
+
+		inf, err := f.Complete(r.Context(), q.Get("state"), q.Get("code"))
+		if err != nil {
+			code := http.StatusInternalServerError
+			msg := "failed to connect account"
+			switch {
+			case errors.Is(err, ErrInvalidState):
+				code, msg = http.StatusBadRequest, err.Error()
+			case errors.Is(err, ErrAccountMismatch), errors.Is(err, ErrAccountConnected):
+				code, msg = http.StatusConflict, err.Error()
+			case errors.Is(err, ErrProvider):
+				code, msg = http.StatusBadGateway, ErrProvider.Error()
+			}
+			log.Printf("connect: %v", err)
+			f.respond(w, r, code, "", msg)
+			return
+		}
+
+		f.respond(w, r, http.StatusOK, inf.ID,
+			fmt.Sprintf("Connected %s account %s", inf.Platform, inf.AccountID))
+	})
+}
+
+// cookie returns the session cookie holding value, scoped to the callback.
+// It is sent along with the platform's redirect, a top-level navigation,
+// but not with requests other sites make.
+func (f *Flow) cookie(value string, maxAge int) *http.Cookie {
+	c := &http.Cookie{
+		Name:     cookieName,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	if u, err := url.Parse(f.config.RedirectURL); err == nil {
+		if u.Path != "" {
+			c.Path = u.Path
+		}
+		c.Secure = u.Scheme == "https"
+	}
+	return c
+}
+
+// startedHere reports whether the request carries the cookie of the
+// session for state
+func startedHere(r *http.Request, state string) bool {
+	c, err := r.Cookie(cookieName)
+	if err != nil || state == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(c.Value), []byte(stateHash(state))) == 1
+}
+
+// stateHash is the value of the session cookie. The state itself isn't
+// stored in the browser.
+func stateHash(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// respond redirects to the return URL with the outcome, or writes it as
+// plain text if no return URL is configured
+func (f *Flow) respond(w http.ResponseWriter, r *http.Request, code int, influencerID, msg string) {
+	if f.config.ReturnURL == "" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(code)
+		fmt.Fprintln(w, msg)
+		return
+	}
+
+	u, err := url.Parse(f.config.ReturnURL)
+	if err != nil {
+		http.Error(w, "invalid return URL", http.StatusInternalServerError)
+		return
+	}
+	q := u.Query()
+	if influencerID != "" {
+		q.Set("influencer_id", influencerID)
+	} else {
+		q.Set("error", msg)
+	}
+	u.RawQuery = q.Encode()
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
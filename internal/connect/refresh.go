@@ -0,0 +1,65 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/WuPinYi/SocialForge/internal/vault"
+)
+
+// RefreshWindow is how long before expiry a token is refreshed
+const RefreshWindow = 15 * time.Minute
+
+// ErrRefreshRejected is returned when the platform refuses to refresh a
+// token, e.g. because access was revoked. Retrying won't help; the account
+// has to be connected again.
+var ErrRefreshRejected = errors.New("platform rejected the token refresh")
+
+// Refresh exchanges the refresh token in secret for a new access token.
+// Errors other than ErrRefreshRejected and ErrUnknownPlatform are transient.
+func (c *Config) Refresh(ctx context.Context, httpClient *http.Client, platform string, secret *vault.Secret) (*vault.Secret, error) {
+	p, ok := c.Providers[platform]
+	if !ok {
+		return nil, ErrUnknownPlatform
+	}
+	if secret.RefreshToken == "" {
+		return nil, fmt.Errorf("%w: no refresh token was issued", ErrRefreshRejected)
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+	source := p.oauth2Config(c.RedirectURL).TokenSource(ctx, &oauth2.Token{
+		RefreshToken: secret.RefreshToken,
+	})
+	token, err := source.Token()
+	if err != nil {
+		var re *oauth2.RetrieveError
+		if errors.As(err, &re) && re.Response != nil &&
+			re.Response.StatusCode >= 400 && re.Response.StatusCode < 500 {
+			reason := re.ErrorCode
+			if reason == "" {
+				reason = re.Response.Status
+			}
+			return nil, fmt.Errorf("%w: %s", ErrRefreshRejected, reason)
+		}
+		return nil, fmt.Errorf("failed to refresh token: %v", err)
+	}
+
+	refreshed := *secret
+	refreshed.AccessToken = token.AccessToken
+	refreshed.Expiry = time.Time{}
+	if token.RefreshToken != "" {
+		refreshed.RefreshToken = token.RefreshToken
+	}
+	if token.TokenType != "" {
+		refreshed.TokenType = token.TokenType
+	}
+	if !token.Expiry.IsZero() {
+		refreshed.Expiry = token.Expiry.UTC().Truncate(time.Second)
+	}
+	return &refreshed, nil
+}
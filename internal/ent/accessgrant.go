@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+)
+
+// AccessGrant is the model entity for the AccessGrant schema.
+type AccessGrant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// InfluencerID holds the value of the "influencer_id" field.
+	InfluencerID string `json:"influencer_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Level holds the value of the "level" field.
+	Level string `json:"level,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// GrantedBy holds the value of the "granted_by" field.
+	GrantedBy string `json:"granted_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccessGrantQuery when eager-loading is set.
+	Edges        AccessGrantEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccessGrantEdges holds the relations/edges for other nodes in the graph.
+type AccessGrantEdges struct {
+	// Influencer holds the value of the influencer edge.
+	Influencer *Influencer `json:"influencer,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// InfluencerOrErr returns the Influencer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessGrantEdges) InfluencerOrErr() (*Influencer, error) {
+	if e.Influencer != nil {
+		return e.Influencer, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: influencer.Label}
+	}
+	return nil, &NotLoadedError{edge: "influencer"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessGrantEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccessGrant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accessgrant.FieldID, accessgrant.FieldInfluencerID, accessgrant.FieldUserID, accessgrant.FieldLevel, accessgrant.FieldGrantedBy:
+			values[i] = new(sql.NullString)
+		case accessgrant.FieldExpiresAt, accessgrant.FieldCreatedAt, accessgrant.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccessGrant fields.
+func (ag *AccessGrant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accessgrant.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ag.ID = value.String
+			}
+		case accessgrant.FieldInfluencerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field influencer_id", values[i])
+			} else if value.Valid {
+				ag.InfluencerID = value.String
+			}
+		case accessgrant.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ag.UserID = value.String
+			}
+		case accessgrant.FieldLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				ag.Level = value.String
+			}
+		case accessgrant.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ag.ExpiresAt = new(time.Time)
+				*ag.ExpiresAt = value.Time
+			}
+		case accessgrant.FieldGrantedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field granted_by", values[i])
+			} else if value.Valid {
+				ag.GrantedBy = value.String
+			}
+		case accessgrant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ag.CreatedAt = value.Time
+			}
+		case accessgrant.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ag.UpdatedAt = value.Time
+			}
+		default:
+			ag.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccessGrant.
+// This includes values selected through modifiers, order, etc.
+func (ag *AccessGrant) Value(name string) (ent.Value, error) {
+	return ag.selectValues.Get(name)
+}
+
+// QueryInfluencer queries the "influencer" edge of the AccessGrant entity.
+func (ag *AccessGrant) QueryInfluencer() *InfluencerQuery {
+	return NewAccessGrantClient(ag.config).QueryInfluencer(ag)
+}
+
+// QueryUser queries the "user" edge of the AccessGrant entity.
+func (ag *AccessGrant) QueryUser() *UserQuery {
+	return NewAccessGrantClient(ag.config).QueryUser(ag)
+}
+
+// Update returns a builder for updating this AccessGrant.
+// Note that you need to call AccessGrant.Unwrap() before calling this method if this AccessGrant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ag *AccessGrant) Update() *AccessGrantUpdateOne {
+	return NewAccessGrantClient(ag.config).UpdateOne(ag)
+}
+
+// Unwrap unwraps the AccessGrant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ag *AccessGrant) Unwrap() *AccessGrant {
+	_tx, ok := ag.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccessGrant is not a transactional entity")
+	}
+	ag.config.driver = _tx.drv
+	return ag
+}
+
+// String implements the fmt.Stringer.
+func (ag *AccessGrant) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccessGrant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ag.ID))
+	builder.WriteString("influencer_id=")
+	builder.WriteString(ag.InfluencerID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(ag.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(ag.Level)
+	builder.WriteString(", ")
+	if v := ag.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("granted_by=")
+	builder.WriteString(ag.GrantedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ag.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ag.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccessGrants is a parsable slice of AccessGrant.
+type AccessGrants []*AccessGrant
@@ -0,0 +1,552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accessgrant
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldContainsFold(FieldID, id))
+}
+
+// InfluencerID applies equality check predicate on the "influencer_id" field. It's identical to InfluencerIDEQ.
+func InfluencerID(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldInfluencerID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldUserID, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldLevel, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// GrantedBy applies equality check predicate on the "granted_by" field. It's identical to GrantedByEQ.
+func GrantedBy(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// InfluencerIDEQ applies the EQ predicate on the "influencer_id" field.
+func InfluencerIDEQ(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldInfluencerID, v))
+}
+
+// InfluencerIDNEQ applies the NEQ predicate on the "influencer_id" field.
+func InfluencerIDNEQ(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldInfluencerID, v))
+}
+
+// InfluencerIDIn applies the In predicate on the "influencer_id" field.
+func InfluencerIDIn(vs ...string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldInfluencerID, vs...))
+}
+
+// InfluencerIDNotIn applies the NotIn predicate on the "influencer_id" field.
+func InfluencerIDNotIn(vs ...string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldInfluencerID, vs...))
+}
+
+// InfluencerIDGT applies the GT predicate on the "influencer_id" field.
+func InfluencerIDGT(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldInfluencerID, v))
+}
+
+// InfluencerIDGTE applies the GTE predicate on the "influencer_id" field.
+func InfluencerIDGTE(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldInfluencerID, v))
+}
+
+// InfluencerIDLT applies the LT predicate on the "influencer_id" field.
+func InfluencerIDLT(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldInfluencerID, v))
+}
+
+// InfluencerIDLTE applies the LTE predicate on the "influencer_id" field.
+func InfluencerIDLTE(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldInfluencerID, v))
+}
+
+// InfluencerIDContains applies the Contains predicate on the "influencer_id" field.
+func InfluencerIDContains(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldContains(FieldInfluencerID, v))
+}
+
+// InfluencerIDHasPrefix applies the HasPrefix predicate on the "influencer_id" field.
+func InfluencerIDHasPrefix(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldHasPrefix(FieldInfluencerID, v))
+}
+
+// InfluencerIDHasSuffix applies the HasSuffix predicate on the "influencer_id" field.
+func InfluencerIDHasSuffix(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldHasSuffix(FieldInfluencerID, v))
+}
+
+// InfluencerIDEqualFold applies the EqualFold predicate on the "influencer_id" field.
+func InfluencerIDEqualFold(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEqualFold(FieldInfluencerID, v))
+}
+
+// InfluencerIDContainsFold applies the ContainsFold predicate on the "influencer_id" field.
+func InfluencerIDContainsFold(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldContainsFold(FieldInfluencerID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldLevel, v))
+}
+
+// LevelContains applies the Contains predicate on the "level" field.
+func LevelContains(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldContains(FieldLevel, v))
+}
+
+// LevelHasPrefix applies the HasPrefix predicate on the "level" field.
+func LevelHasPrefix(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldHasPrefix(FieldLevel, v))
+}
+
+// LevelHasSuffix applies the HasSuffix predicate on the "level" field.
+func LevelHasSuffix(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldHasSuffix(FieldLevel, v))
+}
+
+// LevelEqualFold applies the EqualFold predicate on the "level" field.
+func LevelEqualFold(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEqualFold(FieldLevel, v))
+}
+
+// LevelContainsFold applies the ContainsFold predicate on the "level" field.
+func LevelContainsFold(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldContainsFold(FieldLevel, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// GrantedByEQ applies the EQ predicate on the "granted_by" field.
+func GrantedByEQ(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// GrantedByNEQ applies the NEQ predicate on the "granted_by" field.
+func GrantedByNEQ(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldGrantedBy, v))
+}
+
+// GrantedByIn applies the In predicate on the "granted_by" field.
+func GrantedByIn(vs ...string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByNotIn applies the NotIn predicate on the "granted_by" field.
+func GrantedByNotIn(vs ...string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByGT applies the GT predicate on the "granted_by" field.
+func GrantedByGT(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldGrantedBy, v))
+}
+
+// GrantedByGTE applies the GTE predicate on the "granted_by" field.
+func GrantedByGTE(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldGrantedBy, v))
+}
+
+// GrantedByLT applies the LT predicate on the "granted_by" field.
+func GrantedByLT(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldGrantedBy, v))
+}
+
+// GrantedByLTE applies the LTE predicate on the "granted_by" field.
+func GrantedByLTE(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldGrantedBy, v))
+}
+
+// GrantedByContains applies the Contains predicate on the "granted_by" field.
+func GrantedByContains(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldContains(FieldGrantedBy, v))
+}
+
+// GrantedByHasPrefix applies the HasPrefix predicate on the "granted_by" field.
+func GrantedByHasPrefix(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldHasPrefix(FieldGrantedBy, v))
+}
+
+// GrantedByHasSuffix applies the HasSuffix predicate on the "granted_by" field.
+func GrantedByHasSuffix(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldHasSuffix(FieldGrantedBy, v))
+}
+
+// GrantedByEqualFold applies the EqualFold predicate on the "granted_by" field.
+func GrantedByEqualFold(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEqualFold(FieldGrantedBy, v))
+}
+
+// GrantedByContainsFold applies the ContainsFold predicate on the "granted_by" field.
+func GrantedByContainsFold(v string) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldContainsFold(FieldGrantedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasInfluencer applies the HasEdge predicate on the "influencer" edge.
+func HasInfluencer() predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InfluencerTable, InfluencerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInfluencerWith applies the HasEdge predicate on the "influencer" edge with a given conditions (other predicates).
+func HasInfluencerWith(preds ...predicate.Influencer) predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := newInfluencerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.AccessGrant {
+	return predicate.AccessGrant(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccessGrant) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccessGrant) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccessGrant) predicate.AccessGrant {
+	return predicate.AccessGrant(sql.NotPredicates(p))
+}
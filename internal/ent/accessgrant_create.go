@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+)
+
+// AccessGrantCreate is the builder for creating a AccessGrant entity.
+type AccessGrantCreate struct {
+	config
+	mutation *AccessGrantMutation
+	hooks    []Hook
+}
+
+// SetInfluencerID sets the "influencer_id" field.
+func (agc *AccessGrantCreate) SetInfluencerID(s string) *AccessGrantCreate {
+	agc.mutation.SetInfluencerID(s)
+	return agc
+}
+
+// SetUserID sets the "user_id" field.
+func (agc *AccessGrantCreate) SetUserID(s string) *AccessGrantCreate {
+	agc.mutation.SetUserID(s)
+	return agc
+}
+
+// SetLevel sets the "level" field.
+func (agc *AccessGrantCreate) SetLevel(s string) *AccessGrantCreate {
+	agc.mutation.SetLevel(s)
+	return agc
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableLevel(s *string) *AccessGrantCreate {
+	if s != nil {
+		agc.SetLevel(*s)
+	}
+	return agc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (agc *AccessGrantCreate) SetExpiresAt(t time.Time) *AccessGrantCreate {
+	agc.mutation.SetExpiresAt(t)
+	return agc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableExpiresAt(t *time.Time) *AccessGrantCreate {
+	if t != nil {
+		agc.SetExpiresAt(*t)
+	}
+	return agc
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (agc *AccessGrantCreate) SetGrantedBy(s string) *AccessGrantCreate {
+	agc.mutation.SetGrantedBy(s)
+	return agc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (agc *AccessGrantCreate) SetCreatedAt(t time.Time) *AccessGrantCreate {
+	agc.mutation.SetCreatedAt(t)
+	return agc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableCreatedAt(t *time.Time) *AccessGrantCreate {
+	if t != nil {
+		agc.SetCreatedAt(*t)
+	}
+	return agc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (agc *AccessGrantCreate) SetUpdatedAt(t time.Time) *AccessGrantCreate {
+	agc.mutation.SetUpdatedAt(t)
+	return agc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (agc *AccessGrantCreate) SetNillableUpdatedAt(t *time.Time) *AccessGrantCreate {
+	if t != nil {
+		agc.SetUpdatedAt(*t)
+	}
+	return agc
+}
+
+// SetID sets the "id" field.
+func (agc *AccessGrantCreate) SetID(s string) *AccessGrantCreate {
+	agc.mutation.SetID(s)
+	return agc
+}
+
+// SetInfluencer sets the "influencer" edge to the Influencer entity.
+func (agc *AccessGrantCreate) SetInfluencer(i *Influencer) *AccessGrantCreate {
+	return agc.SetInfluencerID(i.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (agc *AccessGrantCreate) SetUser(u *User) *AccessGrantCreate {
+	return agc.SetUserID(u.ID)
+}
+
+// Mutation returns the AccessGrantMutation object of the builder.
+func (agc *AccessGrantCreate) Mutation() *AccessGrantMutation {
+	return agc.mutation
+}
+
+// Save creates the AccessGrant in the database.
+func (agc *AccessGrantCreate) Save(ctx context.Context) (*AccessGrant, error) {
+	agc.defaults()
+	return withHooks(ctx, agc.sqlSave, agc.mutation, agc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (agc *AccessGrantCreate) SaveX(ctx context.Context) *AccessGrant {
+	v, err := agc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (agc *AccessGrantCreate) Exec(ctx context.Context) error {
+	_, err := agc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (agc *AccessGrantCreate) ExecX(ctx context.Context) {
+	if err := agc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (agc *AccessGrantCreate) defaults() {
+	if _, ok := agc.mutation.Level(); !ok {
+		v := accessgrant.DefaultLevel
+		agc.mutation.SetLevel(v)
+	}
+	if _, ok := agc.mutation.CreatedAt(); !ok {
+		v := accessgrant.DefaultCreatedAt()
+		agc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := agc.mutation.UpdatedAt(); !ok {
+		v := accessgrant.DefaultUpdatedAt()
+		agc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (agc *AccessGrantCreate) check() error {
+	if _, ok := agc.mutation.InfluencerID(); !ok {
+		return &ValidationError{Name: "influencer_id", err: errors.New(`ent: missing required field "AccessGrant.influencer_id"`)}
+	}
+	if _, ok := agc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AccessGrant.user_id"`)}
+	}
+	if _, ok := agc.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "AccessGrant.level"`)}
+	}
+	if _, ok := agc.mutation.GrantedBy(); !ok {
+		return &ValidationError{Name: "granted_by", err: errors.New(`ent: missing required field "AccessGrant.granted_by"`)}
+	}
+	if _, ok := agc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccessGrant.created_at"`)}
+	}
+	if _, ok := agc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AccessGrant.updated_at"`)}
+	}
+	if len(agc.mutation.InfluencerIDs()) == 0 {
+		return &ValidationError{Name: "influencer", err: errors.New(`ent: missing required edge "AccessGrant.influencer"`)}
+	}
+	if len(agc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AccessGrant.user"`)}
+	}
+	return nil
+}
+
+func (agc *AccessGrantCreate) sqlSave(ctx context.Context) (*AccessGrant, error) {
+	if err := agc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := agc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, agc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AccessGrant.ID type: %T", _spec.ID.Value)
+		}
+	}
+	agc.mutation.id = &_node.ID
+	agc.mutation.done = true
+	return _node, nil
+}
+
+func (agc *AccessGrantCreate) createSpec() (*AccessGrant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessGrant{config: agc.config}
+		_spec = sqlgraph.NewCreateSpec(accessgrant.Table, sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeString))
+	)
+	if id, ok := agc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := agc.mutation.Level(); ok {
+		_spec.SetField(accessgrant.FieldLevel, field.TypeString, value)
+		_node.Level = value
+	}
+	if value, ok := agc.mutation.ExpiresAt(); ok {
+		_spec.SetField(accessgrant.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := agc.mutation.GrantedBy(); ok {
+		_spec.SetField(accessgrant.FieldGrantedBy, field.TypeString, value)
+		_node.GrantedBy = value
+	}
+	if value, ok := agc.mutation.CreatedAt(); ok {
+		_spec.SetField(accessgrant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := agc.mutation.UpdatedAt(); ok {
+		_spec.SetField(accessgrant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := agc.mutation.InfluencerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.InfluencerTable,
+			Columns: []string{accessgrant.InfluencerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(influencer.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InfluencerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := agc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.UserTable,
+			Columns: []string{accessgrant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AccessGrantCreateBulk is the builder for creating many AccessGrant entities in bulk.
+type AccessGrantCreateBulk struct {
+	config
+	err      error
+	builders []*AccessGrantCreate
+}
+
+// Save creates the AccessGrant entities in the database.
+func (agcb *AccessGrantCreateBulk) Save(ctx context.Context) ([]*AccessGrant, error) {
+	if agcb.err != nil {
+		return nil, agcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(agcb.builders))
+	nodes := make([]*AccessGrant, len(agcb.builders))
+	mutators := make([]Mutator, len(agcb.builders))
+	for i := range agcb.builders {
+		func(i int, root context.Context) {
+			builder := agcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessGrantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, agcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, agcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, agcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (agcb *AccessGrantCreateBulk) SaveX(ctx context.Context) []*AccessGrant {
+	v, err := agcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (agcb *AccessGrantCreateBulk) Exec(ctx context.Context) error {
+	_, err := agcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (agcb *AccessGrantCreateBulk) ExecX(ctx context.Context) {
+	if err := agcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
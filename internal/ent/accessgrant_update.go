@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+)
+
+// AccessGrantUpdate is the builder for updating AccessGrant entities.
+type AccessGrantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessGrantMutation
+}
+
+// Where appends a list predicates to the AccessGrantUpdate builder.
+func (agu *AccessGrantUpdate) Where(ps ...predicate.AccessGrant) *AccessGrantUpdate {
+	agu.mutation.Where(ps...)
+	return agu
+}
+
+// SetInfluencerID sets the "influencer_id" field.
+func (agu *AccessGrantUpdate) SetInfluencerID(s string) *AccessGrantUpdate {
+	agu.mutation.SetInfluencerID(s)
+	return agu
+}
+
+// SetNillableInfluencerID sets the "influencer_id" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableInfluencerID(s *string) *AccessGrantUpdate {
+	if s != nil {
+		agu.SetInfluencerID(*s)
+	}
+	return agu
+}
+
+// SetUserID sets the "user_id" field.
+func (agu *AccessGrantUpdate) SetUserID(s string) *AccessGrantUpdate {
+	agu.mutation.SetUserID(s)
+	return agu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableUserID(s *string) *AccessGrantUpdate {
+	if s != nil {
+		agu.SetUserID(*s)
+	}
+	return agu
+}
+
+// SetLevel sets the "level" field.
+func (agu *AccessGrantUpdate) SetLevel(s string) *AccessGrantUpdate {
+	agu.mutation.SetLevel(s)
+	return agu
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableLevel(s *string) *AccessGrantUpdate {
+	if s != nil {
+		agu.SetLevel(*s)
+	}
+	return agu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (agu *AccessGrantUpdate) SetExpiresAt(t time.Time) *AccessGrantUpdate {
+	agu.mutation.SetExpiresAt(t)
+	return agu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableExpiresAt(t *time.Time) *AccessGrantUpdate {
+	if t != nil {
+		agu.SetExpiresAt(*t)
+	}
+	return agu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (agu *AccessGrantUpdate) ClearExpiresAt() *AccessGrantUpdate {
+	agu.mutation.ClearExpiresAt()
+	return agu
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (agu *AccessGrantUpdate) SetGrantedBy(s string) *AccessGrantUpdate {
+	agu.mutation.SetGrantedBy(s)
+	return agu
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (agu *AccessGrantUpdate) SetNillableGrantedBy(s *string) *AccessGrantUpdate {
+	if s != nil {
+		agu.SetGrantedBy(*s)
+	}
+	return agu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (agu *AccessGrantUpdate) SetUpdatedAt(t time.Time) *AccessGrantUpdate {
+	agu.mutation.SetUpdatedAt(t)
+	return agu
+}
+
+// SetInfluencer sets the "influencer" edge to the Influencer entity.
+func (agu *AccessGrantUpdate) SetInfluencer(i *Influencer) *AccessGrantUpdate {
+	return agu.SetInfluencerID(i.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (agu *AccessGrantUpdate) SetUser(u *User) *AccessGrantUpdate {
+	return agu.SetUserID(u.ID)
+}
+
+// Mutation returns the AccessGrantMutation object of the builder.
+func (agu *AccessGrantUpdate) Mutation() *AccessGrantMutation {
+	return agu.mutation
+}
+
+// ClearInfluencer clears the "influencer" edge to the Influencer entity.
+func (agu *AccessGrantUpdate) ClearInfluencer() *AccessGrantUpdate {
+	agu.mutation.ClearInfluencer()
+	return agu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (agu *AccessGrantUpdate) ClearUser() *AccessGrantUpdate {
+	agu.mutation.ClearUser()
+	return agu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (agu *AccessGrantUpdate) Save(ctx context.Context) (int, error) {
+	agu.defaults()
+	return withHooks(ctx, agu.sqlSave, agu.mutation, agu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (agu *AccessGrantUpdate) SaveX(ctx context.Context) int {
+	affected, err := agu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (agu *AccessGrantUpdate) Exec(ctx context.Context) error {
+	_, err := agu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (agu *AccessGrantUpdate) ExecX(ctx context.Context) {
+	if err := agu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (agu *AccessGrantUpdate) defaults() {
+	if _, ok := agu.mutation.UpdatedAt(); !ok {
+		v := accessgrant.UpdateDefaultUpdatedAt()
+		agu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (agu *AccessGrantUpdate) check() error {
+	if agu.mutation.InfluencerCleared() && len(agu.mutation.InfluencerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccessGrant.influencer"`)
+	}
+	if agu.mutation.UserCleared() && len(agu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccessGrant.user"`)
+	}
+	return nil
+}
+
+func (agu *AccessGrantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := agu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accessgrant.Table, accessgrant.Columns, sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeString))
+	if ps := agu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := agu.mutation.Level(); ok {
+		_spec.SetField(accessgrant.FieldLevel, field.TypeString, value)
+	}
+	if value, ok := agu.mutation.ExpiresAt(); ok {
+		_spec.SetField(accessgrant.FieldExpiresAt, field.TypeTime, value)
+	}
+	if agu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(accessgrant.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := agu.mutation.GrantedBy(); ok {
+		_spec.SetField(accessgrant.FieldGrantedBy, field.TypeString, value)
+	}
+	if value, ok := agu.mutation.UpdatedAt(); ok {
+		_spec.SetField(accessgrant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if agu.mutation.InfluencerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.InfluencerTable,
+			Columns: []string{accessgrant.InfluencerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(influencer.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := agu.mutation.InfluencerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.InfluencerTable,
+			Columns: []string{accessgrant.InfluencerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(influencer.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if agu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.UserTable,
+			Columns: []string{accessgrant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := agu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.UserTable,
+			Columns: []string{accessgrant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, agu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accessgrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	agu.mutation.done = true
+	return n, nil
+}
+
+// AccessGrantUpdateOne is the builder for updating a single AccessGrant entity.
+type AccessGrantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessGrantMutation
+}
+
+// SetInfluencerID sets the "influencer_id" field.
+func (aguo *AccessGrantUpdateOne) SetInfluencerID(s string) *AccessGrantUpdateOne {
+	aguo.mutation.SetInfluencerID(s)
+	return aguo
+}
+
+// SetNillableInfluencerID sets the "influencer_id" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableInfluencerID(s *string) *AccessGrantUpdateOne {
+	if s != nil {
+		aguo.SetInfluencerID(*s)
+	}
+	return aguo
+}
+
+// SetUserID sets the "user_id" field.
+func (aguo *AccessGrantUpdateOne) SetUserID(s string) *AccessGrantUpdateOne {
+	aguo.mutation.SetUserID(s)
+	return aguo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableUserID(s *string) *AccessGrantUpdateOne {
+	if s != nil {
+		aguo.SetUserID(*s)
+	}
+	return aguo
+}
+
+// SetLevel sets the "level" field.
+func (aguo *AccessGrantUpdateOne) SetLevel(s string) *AccessGrantUpdateOne {
+	aguo.mutation.SetLevel(s)
+	return aguo
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableLevel(s *string) *AccessGrantUpdateOne {
+	if s != nil {
+		aguo.SetLevel(*s)
+	}
+	return aguo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (aguo *AccessGrantUpdateOne) SetExpiresAt(t time.Time) *AccessGrantUpdateOne {
+	aguo.mutation.SetExpiresAt(t)
+	return aguo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableExpiresAt(t *time.Time) *AccessGrantUpdateOne {
+	if t != nil {
+		aguo.SetExpiresAt(*t)
+	}
+	return aguo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (aguo *AccessGrantUpdateOne) ClearExpiresAt() *AccessGrantUpdateOne {
+	aguo.mutation.ClearExpiresAt()
+	return aguo
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (aguo *AccessGrantUpdateOne) SetGrantedBy(s string) *AccessGrantUpdateOne {
+	aguo.mutation.SetGrantedBy(s)
+	return aguo
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (aguo *AccessGrantUpdateOne) SetNillableGrantedBy(s *string) *AccessGrantUpdateOne {
+	if s != nil {
+		aguo.SetGrantedBy(*s)
+	}
+	return aguo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aguo *AccessGrantUpdateOne) SetUpdatedAt(t time.Time) *AccessGrantUpdateOne {
+	aguo.mutation.SetUpdatedAt(t)
+	return aguo
+}
+
+// SetInfluencer sets the "influencer" edge to the Influencer entity.
+func (aguo *AccessGrantUpdateOne) SetInfluencer(i *Influencer) *AccessGrantUpdateOne {
+	return aguo.SetInfluencerID(i.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (aguo *AccessGrantUpdateOne) SetUser(u *User) *AccessGrantUpdateOne {
+	return aguo.SetUserID(u.ID)
+}
+
+// Mutation returns the AccessGrantMutation object of the builder.
+func (aguo *AccessGrantUpdateOne) Mutation() *AccessGrantMutation {
+	return aguo.mutation
+}
+
+// ClearInfluencer clears the "influencer" edge to the Influencer entity.
+func (aguo *AccessGrantUpdateOne) ClearInfluencer() *AccessGrantUpdateOne {
+	aguo.mutation.ClearInfluencer()
+	return aguo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (aguo *AccessGrantUpdateOne) ClearUser() *AccessGrantUpdateOne {
+	aguo.mutation.ClearUser()
+	return aguo
+}
+
+// Where appends a list predicates to the AccessGrantUpdate builder.
+func (aguo *AccessGrantUpdateOne) Where(ps ...predicate.AccessGrant) *AccessGrantUpdateOne {
+	aguo.mutation.Where(ps...)
+	return aguo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aguo *AccessGrantUpdateOne) Select(field string, fields ...string) *AccessGrantUpdateOne {
+	aguo.fields = append([]string{field}, fields...)
+	return aguo
+}
+
+// Save executes the query and returns the updated AccessGrant entity.
+func (aguo *AccessGrantUpdateOne) Save(ctx context.Context) (*AccessGrant, error) {
+	aguo.defaults()
+	return withHooks(ctx, aguo.sqlSave, aguo.mutation, aguo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aguo *AccessGrantUpdateOne) SaveX(ctx context.Context) *AccessGrant {
+	node, err := aguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aguo *AccessGrantUpdateOne) Exec(ctx context.Context) error {
+	_, err := aguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aguo *AccessGrantUpdateOne) ExecX(ctx context.Context) {
+	if err := aguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aguo *AccessGrantUpdateOne) defaults() {
+	if _, ok := aguo.mutation.UpdatedAt(); !ok {
+		v := accessgrant.UpdateDefaultUpdatedAt()
+		aguo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aguo *AccessGrantUpdateOne) check() error {
+	if aguo.mutation.InfluencerCleared() && len(aguo.mutation.InfluencerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccessGrant.influencer"`)
+	}
+	if aguo.mutation.UserCleared() && len(aguo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccessGrant.user"`)
+	}
+	return nil
+}
+
+func (aguo *AccessGrantUpdateOne) sqlSave(ctx context.Context) (_node *AccessGrant, err error) {
+	if err := aguo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accessgrant.Table, accessgrant.Columns, sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeString))
+	id, ok := aguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessGrant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accessgrant.FieldID)
+		for _, f := range fields {
+			if !accessgrant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accessgrant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aguo.mutation.Level(); ok {
+		_spec.SetField(accessgrant.FieldLevel, field.TypeString, value)
+	}
+	if value, ok := aguo.mutation.ExpiresAt(); ok {
+		_spec.SetField(accessgrant.FieldExpiresAt, field.TypeTime, value)
+	}
+	if aguo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(accessgrant.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := aguo.mutation.GrantedBy(); ok {
+		_spec.SetField(accessgrant.FieldGrantedBy, field.TypeString, value)
+	}
+	if value, ok := aguo.mutation.UpdatedAt(); ok {
+		_spec.SetField(accessgrant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aguo.mutation.InfluencerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.InfluencerTable,
+			Columns: []string{accessgrant.InfluencerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(influencer.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aguo.mutation.InfluencerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.InfluencerTable,
+			Columns: []string{accessgrant.InfluencerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(influencer.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aguo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.UserTable,
+			Columns: []string{accessgrant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aguo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accessgrant.UserTable,
+			Columns: []string{accessgrant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AccessGrant{config: aguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accessgrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aguo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/auditevent"
+)
+
+// AuditEventCreate is the builder for creating a AuditEvent entity.
+type AuditEventCreate struct {
+	config
+	mutation *AuditEventMutation
+	hooks    []Hook
+}
+
+// SetActorKind sets the "actor_kind" field.
+func (aec *AuditEventCreate) SetActorKind(s string) *AuditEventCreate {
+	aec.mutation.SetActorKind(s)
+	return aec
+}
+
+// SetActorID sets the "actor_id" field.
+func (aec *AuditEventCreate) SetActorID(s string) *AuditEventCreate {
+	aec.mutation.SetActorID(s)
+	return aec
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableActorID(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetActorID(*s)
+	}
+	return aec
+}
+
+// SetActorSubject sets the "actor_subject" field.
+func (aec *AuditEventCreate) SetActorSubject(s string) *AuditEventCreate {
+	aec.mutation.SetActorSubject(s)
+	return aec
+}
+
+// SetNillableActorSubject sets the "actor_subject" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableActorSubject(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetActorSubject(*s)
+	}
+	return aec
+}
+
+// SetServiceAccountID sets the "service_account_id" field.
+func (aec *AuditEventCreate) SetServiceAccountID(s string) *AuditEventCreate {
+	aec.mutation.SetServiceAccountID(s)
+	return aec
+}
+
+// SetNillableServiceAccountID sets the "service_account_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableServiceAccountID(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetServiceAccountID(*s)
+	}
+	return aec
+}
+
+// SetOperation sets the "operation" field.
+func (aec *AuditEventCreate) SetOperation(s string) *AuditEventCreate {
+	aec.mutation.SetOperation(s)
+	return aec
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aec *AuditEventCreate) SetEntityType(s string) *AuditEventCreate {
+	aec.mutation.SetEntityType(s)
+	return aec
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aec *AuditEventCreate) SetEntityID(s string) *AuditEventCreate {
+	aec.mutation.SetEntityID(s)
+	return aec
+}
+
+// SetChanges sets the "changes" field.
+func (aec *AuditEventCreate) SetChanges(m map[string]interface{}) *AuditEventCreate {
+	aec.mutation.SetChanges(m)
+	return aec
+}
+
+// SetRequestID sets the "request_id" field.
+func (aec *AuditEventCreate) SetRequestID(s string) *AuditEventCreate {
+	aec.mutation.SetRequestID(s)
+	return aec
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableRequestID(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetRequestID(*s)
+	}
+	return aec
+}
+
+// SetClientIP sets the "client_ip" field.
+func (aec *AuditEventCreate) SetClientIP(s string) *AuditEventCreate {
+	aec.mutation.SetClientIP(s)
+	return aec
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableClientIP(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetClientIP(*s)
+	}
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuditEventCreate) SetCreatedAt(t time.Time) *AuditEventCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableCreatedAt(t *time.Time) *AuditEventCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AuditEventCreate) SetID(s string) *AuditEventCreate {
+	aec.mutation.SetID(s)
+	return aec
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aec *AuditEventCreate) Mutation() *AuditEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEvent in the database.
+func (aec *AuditEventCreate) Save(ctx context.Context) (*AuditEvent, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEventCreate) SaveX(ctx context.Context) *AuditEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEventCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEventCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEventCreate) defaults() {
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := auditevent.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEventCreate) check() error {
+	if _, ok := aec.mutation.ActorKind(); !ok {
+		return &ValidationError{Name: "actor_kind", err: errors.New(`ent: missing required field "AuditEvent.actor_kind"`)}
+	}
+	if _, ok := aec.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "AuditEvent.operation"`)}
+	}
+	if _, ok := aec.mutation.EntityType(); !ok {
+		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "AuditEvent.entity_type"`)}
+	}
+	if _, ok := aec.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "AuditEvent.entity_id"`)}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (aec *AuditEventCreate) sqlSave(ctx context.Context) (*AuditEvent, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AuditEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEvent{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(auditevent.Table, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeString))
+	)
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := aec.mutation.ActorKind(); ok {
+		_spec.SetField(auditevent.FieldActorKind, field.TypeString, value)
+		_node.ActorKind = value
+	}
+	if value, ok := aec.mutation.ActorID(); ok {
+		_spec.SetField(auditevent.FieldActorID, field.TypeString, value)
+		_node.ActorID = value
+	}
+	if value, ok := aec.mutation.ActorSubject(); ok {
+		_spec.SetField(auditevent.FieldActorSubject, field.TypeString, value)
+		_node.ActorSubject = value
+	}
+	if value, ok := aec.mutation.ServiceAccountID(); ok {
+		_spec.SetField(auditevent.FieldServiceAccountID, field.TypeString, value)
+		_node.ServiceAccountID = value
+	}
+	if value, ok := aec.mutation.Operation(); ok {
+		_spec.SetField(auditevent.FieldOperation, field.TypeString, value)
+		_node.Operation = value
+	}
+	if value, ok := aec.mutation.EntityType(); ok {
+		_spec.SetField(auditevent.FieldEntityType, field.TypeString, value)
+		_node.EntityType = value
+	}
+	if value, ok := aec.mutation.EntityID(); ok {
+		_spec.SetField(auditevent.FieldEntityID, field.TypeString, value)
+		_node.EntityID = value
+	}
+	if value, ok := aec.mutation.Changes(); ok {
+		_spec.SetField(auditevent.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := aec.mutation.RequestID(); ok {
+		_spec.SetField(auditevent.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := aec.mutation.ClientIP(); ok {
+		_spec.SetField(auditevent.FieldClientIP, field.TypeString, value)
+		_node.ClientIP = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.SetField(auditevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditEventCreateBulk is the builder for creating many AuditEvent entities in bulk.
+type AuditEventCreateBulk struct {
+	config
+	err      error
+	builders []*AuditEventCreate
+}
+
+// Save creates the AuditEvent entities in the database.
+func (aecb *AuditEventCreateBulk) Save(ctx context.Context) ([]*AuditEvent, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) SaveX(ctx context.Context) []*AuditEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/WuPinYi/SocialForge/internal/ent/connectsession"
+)
+
+// ConnectSession is the model entity for the ConnectSession schema.
+type ConnectSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// CodeVerifier holds the value of the "code_verifier" field.
+	CodeVerifier string `json:"-"`
+	// Platform holds the value of the "platform" field.
+	Platform string `json:"platform,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID string `json:"organization_id,omitempty"`
+	// InfluencerID holds the value of the "influencer_id" field.
+	InfluencerID string `json:"influencer_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// RedirectURL holds the value of the "redirect_url" field.
+	RedirectURL string `json:"redirect_url,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConnectSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case connectsession.FieldID, connectsession.FieldState, connectsession.FieldCodeVerifier, connectsession.FieldPlatform, connectsession.FieldUserID, connectsession.FieldOrganizationID, connectsession.FieldInfluencerID, connectsession.FieldName, connectsession.FieldRedirectURL:
+			values[i] = new(sql.NullString)
+		case connectsession.FieldExpiresAt, connectsession.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConnectSession fields.
+func (cs *ConnectSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case connectsession.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				cs.ID = value.String
+			}
+		case connectsession.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				cs.State = value.String
+			}
+		case connectsession.FieldCodeVerifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_verifier", values[i])
+			} else if value.Valid {
+				cs.CodeVerifier = value.String
+			}
+		case connectsession.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				cs.Platform = value.String
+			}
+		case connectsession.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				cs.UserID = value.String
+			}
+		case connectsession.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				cs.OrganizationID = value.String
+			}
+		case connectsession.FieldInfluencerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field influencer_id", values[i])
+			} else if value.Valid {
+				cs.InfluencerID = value.String
+			}
+		case connectsession.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cs.Name = value.String
+			}
+		case connectsession.FieldRedirectURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_url", values[i])
+			} else if value.Valid {
+				cs.RedirectURL = value.String
+			}
+		case connectsession.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				cs.ExpiresAt = value.Time
+			}
+		case connectsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cs.CreatedAt = value.Time
+			}
+		default:
+			cs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConnectSession.
+// This includes values selected through modifiers, order, etc.
+func (cs *ConnectSession) Value(name string) (ent.Value, error) {
+	return cs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConnectSession.
+// Note that you need to call ConnectSession.Unwrap() before calling this method if this ConnectSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *ConnectSession) Update() *ConnectSessionUpdateOne {
+	return NewConnectSessionClient(cs.config).UpdateOne(cs)
+}
+
+// Unwrap unwraps the ConnectSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *ConnectSession) Unwrap() *ConnectSession {
+	_tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConnectSession is not a transactional entity")
+	}
+	cs.config.driver = _tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *ConnectSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConnectSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	builder.WriteString("state=")
+	builder.WriteString(cs.State)
+	builder.WriteString(", ")
+	builder.WriteString("code_verifier=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(cs.Platform)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(cs.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(cs.OrganizationID)
+	builder.WriteString(", ")
+	builder.WriteString("influencer_id=")
+	builder.WriteString(cs.InfluencerID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(cs.Name)
+	builder.WriteString(", ")
+	builder.WriteString("redirect_url=")
+	builder.WriteString(cs.RedirectURL)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(cs.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cs.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConnectSessions is a parsable slice of ConnectSession.
+type ConnectSessions []*ConnectSession
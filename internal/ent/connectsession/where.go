@@ -0,0 +1,760 @@
+// Code generated by ent, DO NOT EDIT.
+
+package connectsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContainsFold(FieldID, id))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldState, v))
+}
+
+// CodeVerifier applies equality check predicate on the "code_verifier" field. It's identical to CodeVerifierEQ.
+func CodeVerifier(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// Platform applies equality check predicate on the "platform" field. It's identical to PlatformEQ.
+func Platform(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldPlatform, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldUserID, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// InfluencerID applies equality check predicate on the "influencer_id" field. It's identical to InfluencerIDEQ.
+func InfluencerID(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldInfluencerID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldName, v))
+}
+
+// RedirectURL applies equality check predicate on the "redirect_url" field. It's identical to RedirectURLEQ.
+func RedirectURL(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldRedirectURL, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContainsFold(FieldState, v))
+}
+
+// CodeVerifierEQ applies the EQ predicate on the "code_verifier" field.
+func CodeVerifierEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierNEQ applies the NEQ predicate on the "code_verifier" field.
+func CodeVerifierNEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierIn applies the In predicate on the "code_verifier" field.
+func CodeVerifierIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierNotIn applies the NotIn predicate on the "code_verifier" field.
+func CodeVerifierNotIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierGT applies the GT predicate on the "code_verifier" field.
+func CodeVerifierGT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierGTE applies the GTE predicate on the "code_verifier" field.
+func CodeVerifierGTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLT applies the LT predicate on the "code_verifier" field.
+func CodeVerifierLT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLTE applies the LTE predicate on the "code_verifier" field.
+func CodeVerifierLTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContains applies the Contains predicate on the "code_verifier" field.
+func CodeVerifierContains(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContains(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasPrefix applies the HasPrefix predicate on the "code_verifier" field.
+func CodeVerifierHasPrefix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasPrefix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasSuffix applies the HasSuffix predicate on the "code_verifier" field.
+func CodeVerifierHasSuffix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasSuffix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierEqualFold applies the EqualFold predicate on the "code_verifier" field.
+func CodeVerifierEqualFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEqualFold(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContainsFold applies the ContainsFold predicate on the "code_verifier" field.
+func CodeVerifierContainsFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContainsFold(FieldCodeVerifier, v))
+}
+
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldPlatform, v))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldPlatform, v))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldPlatform, vs...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldPlatform, vs...))
+}
+
+// PlatformGT applies the GT predicate on the "platform" field.
+func PlatformGT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldPlatform, v))
+}
+
+// PlatformGTE applies the GTE predicate on the "platform" field.
+func PlatformGTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldPlatform, v))
+}
+
+// PlatformLT applies the LT predicate on the "platform" field.
+func PlatformLT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldPlatform, v))
+}
+
+// PlatformLTE applies the LTE predicate on the "platform" field.
+func PlatformLTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldPlatform, v))
+}
+
+// PlatformContains applies the Contains predicate on the "platform" field.
+func PlatformContains(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContains(FieldPlatform, v))
+}
+
+// PlatformHasPrefix applies the HasPrefix predicate on the "platform" field.
+func PlatformHasPrefix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasPrefix(FieldPlatform, v))
+}
+
+// PlatformHasSuffix applies the HasSuffix predicate on the "platform" field.
+func PlatformHasSuffix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasSuffix(FieldPlatform, v))
+}
+
+// PlatformEqualFold applies the EqualFold predicate on the "platform" field.
+func PlatformEqualFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEqualFold(FieldPlatform, v))
+}
+
+// PlatformContainsFold applies the ContainsFold predicate on the "platform" field.
+func PlatformContainsFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContainsFold(FieldPlatform, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDContains applies the Contains predicate on the "organization_id" field.
+func OrganizationIDContains(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContains(FieldOrganizationID, v))
+}
+
+// OrganizationIDHasPrefix applies the HasPrefix predicate on the "organization_id" field.
+func OrganizationIDHasPrefix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasPrefix(FieldOrganizationID, v))
+}
+
+// OrganizationIDHasSuffix applies the HasSuffix predicate on the "organization_id" field.
+func OrganizationIDHasSuffix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasSuffix(FieldOrganizationID, v))
+}
+
+// OrganizationIDEqualFold applies the EqualFold predicate on the "organization_id" field.
+func OrganizationIDEqualFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEqualFold(FieldOrganizationID, v))
+}
+
+// OrganizationIDContainsFold applies the ContainsFold predicate on the "organization_id" field.
+func OrganizationIDContainsFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContainsFold(FieldOrganizationID, v))
+}
+
+// InfluencerIDEQ applies the EQ predicate on the "influencer_id" field.
+func InfluencerIDEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldInfluencerID, v))
+}
+
+// InfluencerIDNEQ applies the NEQ predicate on the "influencer_id" field.
+func InfluencerIDNEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldInfluencerID, v))
+}
+
+// InfluencerIDIn applies the In predicate on the "influencer_id" field.
+func InfluencerIDIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldInfluencerID, vs...))
+}
+
+// InfluencerIDNotIn applies the NotIn predicate on the "influencer_id" field.
+func InfluencerIDNotIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldInfluencerID, vs...))
+}
+
+// InfluencerIDGT applies the GT predicate on the "influencer_id" field.
+func InfluencerIDGT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldInfluencerID, v))
+}
+
+// InfluencerIDGTE applies the GTE predicate on the "influencer_id" field.
+func InfluencerIDGTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldInfluencerID, v))
+}
+
+// InfluencerIDLT applies the LT predicate on the "influencer_id" field.
+func InfluencerIDLT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldInfluencerID, v))
+}
+
+// InfluencerIDLTE applies the LTE predicate on the "influencer_id" field.
+func InfluencerIDLTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldInfluencerID, v))
+}
+
+// InfluencerIDContains applies the Contains predicate on the "influencer_id" field.
+func InfluencerIDContains(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContains(FieldInfluencerID, v))
+}
+
+// InfluencerIDHasPrefix applies the HasPrefix predicate on the "influencer_id" field.
+func InfluencerIDHasPrefix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasPrefix(FieldInfluencerID, v))
+}
+
+// InfluencerIDHasSuffix applies the HasSuffix predicate on the "influencer_id" field.
+func InfluencerIDHasSuffix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasSuffix(FieldInfluencerID, v))
+}
+
+// InfluencerIDIsNil applies the IsNil predicate on the "influencer_id" field.
+func InfluencerIDIsNil() predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIsNull(FieldInfluencerID))
+}
+
+// InfluencerIDNotNil applies the NotNil predicate on the "influencer_id" field.
+func InfluencerIDNotNil() predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotNull(FieldInfluencerID))
+}
+
+// InfluencerIDEqualFold applies the EqualFold predicate on the "influencer_id" field.
+func InfluencerIDEqualFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEqualFold(FieldInfluencerID, v))
+}
+
+// InfluencerIDContainsFold applies the ContainsFold predicate on the "influencer_id" field.
+func InfluencerIDContainsFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContainsFold(FieldInfluencerID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContainsFold(FieldName, v))
+}
+
+// RedirectURLEQ applies the EQ predicate on the "redirect_url" field.
+func RedirectURLEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldRedirectURL, v))
+}
+
+// RedirectURLNEQ applies the NEQ predicate on the "redirect_url" field.
+func RedirectURLNEQ(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldRedirectURL, v))
+}
+
+// RedirectURLIn applies the In predicate on the "redirect_url" field.
+func RedirectURLIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldRedirectURL, vs...))
+}
+
+// RedirectURLNotIn applies the NotIn predicate on the "redirect_url" field.
+func RedirectURLNotIn(vs ...string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldRedirectURL, vs...))
+}
+
+// RedirectURLGT applies the GT predicate on the "redirect_url" field.
+func RedirectURLGT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldRedirectURL, v))
+}
+
+// RedirectURLGTE applies the GTE predicate on the "redirect_url" field.
+func RedirectURLGTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldRedirectURL, v))
+}
+
+// RedirectURLLT applies the LT predicate on the "redirect_url" field.
+func RedirectURLLT(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldRedirectURL, v))
+}
+
+// RedirectURLLTE applies the LTE predicate on the "redirect_url" field.
+func RedirectURLLTE(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldRedirectURL, v))
+}
+
+// RedirectURLContains applies the Contains predicate on the "redirect_url" field.
+func RedirectURLContains(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContains(FieldRedirectURL, v))
+}
+
+// RedirectURLHasPrefix applies the HasPrefix predicate on the "redirect_url" field.
+func RedirectURLHasPrefix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasPrefix(FieldRedirectURL, v))
+}
+
+// RedirectURLHasSuffix applies the HasSuffix predicate on the "redirect_url" field.
+func RedirectURLHasSuffix(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldHasSuffix(FieldRedirectURL, v))
+}
+
+// RedirectURLIsNil applies the IsNil predicate on the "redirect_url" field.
+func RedirectURLIsNil() predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIsNull(FieldRedirectURL))
+}
+
+// RedirectURLNotNil applies the NotNil predicate on the "redirect_url" field.
+func RedirectURLNotNil() predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotNull(FieldRedirectURL))
+}
+
+// RedirectURLEqualFold applies the EqualFold predicate on the "redirect_url" field.
+func RedirectURLEqualFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEqualFold(FieldRedirectURL, v))
+}
+
+// RedirectURLContainsFold applies the ContainsFold predicate on the "redirect_url" field.
+func RedirectURLContainsFold(v string) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldContainsFold(FieldRedirectURL, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConnectSession) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConnectSession) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConnectSession) predicate.ConnectSession {
+	return predicate.ConnectSession(sql.NotPredicates(p))
+}
@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/connectsession"
+)
+
+// ConnectSessionCreate is the builder for creating a ConnectSession entity.
+type ConnectSessionCreate struct {
+	config
+	mutation *ConnectSessionMutation
+	hooks    []Hook
+}
+
+// SetState sets the "state" field.
+func (csc *ConnectSessionCreate) SetState(s string) *ConnectSessionCreate {
+	csc.mutation.SetState(s)
+	return csc
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (csc *ConnectSessionCreate) SetCodeVerifier(s string) *ConnectSessionCreate {
+	csc.mutation.SetCodeVerifier(s)
+	return csc
+}
+
+// SetPlatform sets the "platform" field.
+func (csc *ConnectSessionCreate) SetPlatform(s string) *ConnectSessionCreate {
+	csc.mutation.SetPlatform(s)
+	return csc
+}
+
+// SetUserID sets the "user_id" field.
+func (csc *ConnectSessionCreate) SetUserID(s string) *ConnectSessionCreate {
+	csc.mutation.SetUserID(s)
+	return csc
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (csc *ConnectSessionCreate) SetOrganizationID(s string) *ConnectSessionCreate {
+	csc.mutation.SetOrganizationID(s)
+	return csc
+}
+
+// SetInfluencerID sets the "influencer_id" field.
+func (csc *ConnectSessionCreate) SetInfluencerID(s string) *ConnectSessionCreate {
+	csc.mutation.SetInfluencerID(s)
+	return csc
+}
+
+// SetNillableInfluencerID sets the "influencer_id" field if the given value is not nil.
+func (csc *ConnectSessionCreate) SetNillableInfluencerID(s *string) *ConnectSessionCreate {
+	if s != nil {
+		csc.SetInfluencerID(*s)
+	}
+	return csc
+}
+
+// SetName sets the "name" field.
+func (csc *ConnectSessionCreate) SetName(s string) *ConnectSessionCreate {
+	csc.mutation.SetName(s)
+	return csc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (csc *ConnectSessionCreate) SetNillableName(s *string) *ConnectSessionCreate {
+	if s != nil {
+		csc.SetName(*s)
+	}
+	return csc
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (csc *ConnectSessionCreate) SetRedirectURL(s string) *ConnectSessionCreate {
+	csc.mutation.SetRedirectURL(s)
+	return csc
+}
+
+// SetNillableRedirectURL sets the "redirect_url" field if the given value is not nil.
+func (csc *ConnectSessionCreate) SetNillableRedirectURL(s *string) *ConnectSessionCreate {
+	if s != nil {
+		csc.SetRedirectURL(*s)
+	}
+	return csc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (csc *ConnectSessionCreate) SetExpiresAt(t time.Time) *ConnectSessionCreate {
+	csc.mutation.SetExpiresAt(t)
+	return csc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csc *ConnectSessionCreate) SetCreatedAt(t time.Time) *ConnectSessionCreate {
+	csc.mutation.SetCreatedAt(t)
+	return csc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csc *ConnectSessionCreate) SetNillableCreatedAt(t *time.Time) *ConnectSessionCreate {
+	if t != nil {
+		csc.SetCreatedAt(*t)
+	}
+	return csc
+}
+
+// SetID sets the "id" field.
+func (csc *ConnectSessionCreate) SetID(s string) *ConnectSessionCreate {
+	csc.mutation.SetID(s)
+	return csc
+}
+
+// Mutation returns the ConnectSessionMutation object of the builder.
+func (csc *ConnectSessionCreate) Mutation() *ConnectSessionMutation {
+	return csc.mutation
+}
+
+// Save creates the ConnectSession in the database.
+func (csc *ConnectSessionCreate) Save(ctx context.Context) (*ConnectSession, error) {
+	csc.defaults()
+	return withHooks(ctx, csc.sqlSave, csc.mutation, csc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *ConnectSessionCreate) SaveX(ctx context.Context) *ConnectSession {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *ConnectSessionCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *ConnectSessionCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *ConnectSessionCreate) defaults() {
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		v := connectsession.DefaultCreatedAt()
+		csc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *ConnectSessionCreate) check() error {
+	if _, ok := csc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "ConnectSession.state"`)}
+	}
+	if _, ok := csc.mutation.CodeVerifier(); !ok {
+		return &ValidationError{Name: "code_verifier", err: errors.New(`ent: missing required field "ConnectSession.code_verifier"`)}
+	}
+	if _, ok := csc.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "ConnectSession.platform"`)}
+	}
+	if _, ok := csc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ConnectSession.user_id"`)}
+	}
+	if _, ok := csc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "ConnectSession.organization_id"`)}
+	}
+	if _, ok := csc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ConnectSession.expires_at"`)}
+	}
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConnectSession.created_at"`)}
+	}
+	return nil
+}
+
+func (csc *ConnectSessionCreate) sqlSave(ctx context.Context) (*ConnectSession, error) {
+	if err := csc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ConnectSession.ID type: %T", _spec.ID.Value)
+		}
+	}
+	csc.mutation.id = &_node.ID
+	csc.mutation.done = true
+	return _node, nil
+}
+
+func (csc *ConnectSessionCreate) createSpec() (*ConnectSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConnectSession{config: csc.config}
+		_spec = sqlgraph.NewCreateSpec(connectsession.Table, sqlgraph.NewFieldSpec(connectsession.FieldID, field.TypeString))
+	)
+	if id, ok := csc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := csc.mutation.State(); ok {
+		_spec.SetField(connectsession.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := csc.mutation.CodeVerifier(); ok {
+		_spec.SetField(connectsession.FieldCodeVerifier, field.TypeString, value)
+		_node.CodeVerifier = value
+	}
+	if value, ok := csc.mutation.Platform(); ok {
+		_spec.SetField(connectsession.FieldPlatform, field.TypeString, value)
+		_node.Platform = value
+	}
+	if value, ok := csc.mutation.UserID(); ok {
+		_spec.SetField(connectsession.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := csc.mutation.OrganizationID(); ok {
+		_spec.SetField(connectsession.FieldOrganizationID, field.TypeString, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := csc.mutation.InfluencerID(); ok {
+		_spec.SetField(connectsession.FieldInfluencerID, field.TypeString, value)
+		_node.InfluencerID = value
+	}
+	if value, ok := csc.mutation.Name(); ok {
+		_spec.SetField(connectsession.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := csc.mutation.RedirectURL(); ok {
+		_spec.SetField(connectsession.FieldRedirectURL, field.TypeString, value)
+		_node.RedirectURL = value
+	}
+	if value, ok := csc.mutation.ExpiresAt(); ok {
+		_spec.SetField(connectsession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := csc.mutation.CreatedAt(); ok {
+		_spec.SetField(connectsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ConnectSessionCreateBulk is the builder for creating many ConnectSession entities in bulk.
+type ConnectSessionCreateBulk struct {
+	config
+	err      error
+	builders []*ConnectSessionCreate
+}
+
+// Save creates the ConnectSession entities in the database.
+func (cscb *ConnectSessionCreateBulk) Save(ctx context.Context) ([]*ConnectSession, error) {
+	if cscb.err != nil {
+		return nil, cscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*ConnectSession, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConnectSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *ConnectSessionCreateBulk) SaveX(ctx context.Context) []*ConnectSession {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *ConnectSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *ConnectSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
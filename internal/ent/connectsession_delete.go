@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/connectsession"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+)
+
+// ConnectSessionDelete is the builder for deleting a ConnectSession entity.
+type ConnectSessionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConnectSessionMutation
+}
+
+// Where appends a list predicates to the ConnectSessionDelete builder.
+func (csd *ConnectSessionDelete) Where(ps ...predicate.ConnectSession) *ConnectSessionDelete {
+	csd.mutation.Where(ps...)
+	return csd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (csd *ConnectSessionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, csd.sqlExec, csd.mutation, csd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csd *ConnectSessionDelete) ExecX(ctx context.Context) int {
+	n, err := csd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (csd *ConnectSessionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(connectsession.Table, sqlgraph.NewFieldSpec(connectsession.FieldID, field.TypeString))
+	if ps := csd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, csd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	csd.mutation.done = true
+	return affected, err
+}
+
+// ConnectSessionDeleteOne is the builder for deleting a single ConnectSession entity.
+type ConnectSessionDeleteOne struct {
+	csd *ConnectSessionDelete
+}
+
+// Where appends a list predicates to the ConnectSessionDelete builder.
+func (csdo *ConnectSessionDeleteOne) Where(ps ...predicate.ConnectSession) *ConnectSessionDeleteOne {
+	csdo.csd.mutation.Where(ps...)
+	return csdo
+}
+
+// Exec executes the deletion query.
+func (csdo *ConnectSessionDeleteOne) Exec(ctx context.Context) error {
+	n, err := csdo.csd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{connectsession.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csdo *ConnectSessionDeleteOne) ExecX(ctx context.Context) {
+	if err := csdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/connectsession"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+)
+
+// ConnectSessionQuery is the builder for querying ConnectSession entities.
+type ConnectSessionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []connectsession.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ConnectSession
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConnectSessionQuery builder.
+func (csq *ConnectSessionQuery) Where(ps ...predicate.ConnectSession) *ConnectSessionQuery {
+	csq.predicates = append(csq.predicates, ps...)
+	return csq
+}
+
+// Limit the number of records to be returned by this query.
+func (csq *ConnectSessionQuery) Limit(limit int) *ConnectSessionQuery {
+	csq.ctx.Limit = &limit
+	return csq
+}
+
+// Offset to start from.
+func (csq *ConnectSessionQuery) Offset(offset int) *ConnectSessionQuery {
+	csq.ctx.Offset = &offset
+	return csq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (csq *ConnectSessionQuery) Unique(unique bool) *ConnectSessionQuery {
+	csq.ctx.Unique = &unique
+	return csq
+}
+
+// Order specifies how the records should be ordered.
+func (csq *ConnectSessionQuery) Order(o ...connectsession.OrderOption) *ConnectSessionQuery {
+	csq.order = append(csq.order, o...)
+	return csq
+}
+
+// First returns the first ConnectSession entity from the query.
+// Returns a *NotFoundError when no ConnectSession was found.
+func (csq *ConnectSessionQuery) First(ctx context.Context) (*ConnectSession, error) {
+	nodes, err := csq.Limit(1).All(setContextOp(ctx, csq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{connectsession.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (csq *ConnectSessionQuery) FirstX(ctx context.Context) *ConnectSession {
+	node, err := csq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConnectSession ID from the query.
+// Returns a *NotFoundError when no ConnectSession ID was found.
+func (csq *ConnectSessionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = csq.Limit(1).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{connectsession.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (csq *ConnectSessionQuery) FirstIDX(ctx context.Context) string {
+	id, err := csq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConnectSession entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConnectSession entity is found.
+// Returns a *NotFoundError when no ConnectSession entities are found.
+func (csq *ConnectSessionQuery) Only(ctx context.Context) (*ConnectSession, error) {
+	nodes, err := csq.Limit(2).All(setContextOp(ctx, csq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{connectsession.Label}
+	default:
+		return nil, &NotSingularError{connectsession.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (csq *ConnectSessionQuery) OnlyX(ctx context.Context) *ConnectSession {
+	node, err := csq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConnectSession ID in the query.
+// Returns a *NotSingularError when more than one ConnectSession ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (csq *ConnectSessionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = csq.Limit(2).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{connectsession.Label}
+	default:
+		err = &NotSingularError{connectsession.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (csq *ConnectSessionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := csq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConnectSessions.
+func (csq *ConnectSessionQuery) All(ctx context.Context) ([]*ConnectSession, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryAll)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConnectSession, *ConnectSessionQuery]()
+	return withInterceptors[[]*ConnectSession](ctx, csq, qr, csq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (csq *ConnectSessionQuery) AllX(ctx context.Context) []*ConnectSession {
+	nodes, err := csq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConnectSession IDs.
+func (csq *ConnectSessionQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if csq.ctx.Unique == nil && csq.path != nil {
+		csq.Unique(true)
+	}
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryIDs)
+	if err = csq.Select(connectsession.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (csq *ConnectSessionQuery) IDsX(ctx context.Context) []string {
+	ids, err := csq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (csq *ConnectSessionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryCount)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, csq, querierCount[*ConnectSessionQuery](), csq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (csq *ConnectSessionQuery) CountX(ctx context.Context) int {
+	count, err := csq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (csq *ConnectSessionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryExist)
+	switch _, err := csq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (csq *ConnectSessionQuery) ExistX(ctx context.Context) bool {
+	exist, err := csq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConnectSessionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (csq *ConnectSessionQuery) Clone() *ConnectSessionQuery {
+	if csq == nil {
+		return nil
+	}
+	return &ConnectSessionQuery{
+		config:     csq.config,
+		ctx:        csq.ctx.Clone(),
+		order:      append([]connectsession.OrderOption{}, csq.order...),
+		inters:     append([]Interceptor{}, csq.inters...),
+		predicates: append([]predicate.ConnectSession{}, csq.predicates...),
+		// clone intermediate query.
+		sql:  csq.sql.Clone(),
+		path: csq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		State string `json:"state,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConnectSession.Query().
+//		GroupBy(connectsession.FieldState).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (csq *ConnectSessionQuery) GroupBy(field string, fields ...string) *ConnectSessionGroupBy {
+	csq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConnectSessionGroupBy{build: csq}
+	grbuild.flds = &csq.ctx.Fields
+	grbuild.label = connectsession.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		State string `json:"state,omitempty"`
+//	}
+//
+//	client.ConnectSession.Query().
+//		Select(connectsession.FieldState).
+//		Scan(ctx, &v)
+func (csq *ConnectSessionQuery) Select(fields ...string) *ConnectSessionSelect {
+	csq.ctx.Fields = append(csq.ctx.Fields, fields...)
+	sbuild := &ConnectSessionSelect{ConnectSessionQuery: csq}
+	sbuild.label = connectsession.Label
+	sbuild.flds, sbuild.scan = &csq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConnectSessionSelect configured with the given aggregations.
+func (csq *ConnectSessionQuery) Aggregate(fns ...AggregateFunc) *ConnectSessionSelect {
+	return csq.Select().Aggregate(fns...)
+}
+
+func (csq *ConnectSessionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range csq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, csq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range csq.ctx.Fields {
+		if !connectsession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if csq.path != nil {
+		prev, err := csq.path(ctx)
+		if err != nil {
+			return err
+		}
+		csq.sql = prev
+	}
+	return nil
+}
+
+func (csq *ConnectSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConnectSession, error) {
+	var (
+		nodes = []*ConnectSession{}
+		_spec = csq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConnectSession).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConnectSession{config: csq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, csq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (csq *ConnectSessionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := csq.querySpec()
+	_spec.Node.Columns = csq.ctx.Fields
+	if len(csq.ctx.Fields) > 0 {
+		_spec.Unique = csq.ctx.Unique != nil && *csq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, csq.driver, _spec)
+}
+
+func (csq *ConnectSessionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(connectsession.Table, connectsession.Columns, sqlgraph.NewFieldSpec(connectsession.FieldID, field.TypeString))
+	_spec.From = csq.sql
+	if unique := csq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if csq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := csq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, connectsession.FieldID)
+		for i := range fields {
+			if fields[i] != connectsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := csq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := csq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (csq *ConnectSessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(csq.driver.Dialect())
+	t1 := builder.Table(connectsession.Table)
+	columns := csq.ctx.Fields
+	if len(columns) == 0 {
+		columns = connectsession.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if csq.sql != nil {
+		selector = csq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if csq.ctx.Unique != nil && *csq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range csq.predicates {
+		p(selector)
+	}
+	for _, p := range csq.order {
+		p(selector)
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ConnectSessionGroupBy is the group-by builder for ConnectSession entities.
+type ConnectSessionGroupBy struct {
+	selector
+	build *ConnectSessionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (csgb *ConnectSessionGroupBy) Aggregate(fns ...AggregateFunc) *ConnectSessionGroupBy {
+	csgb.fns = append(csgb.fns, fns...)
+	return csgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (csgb *ConnectSessionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, csgb.build.ctx, ent.OpQueryGroupBy)
+	if err := csgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConnectSessionQuery, *ConnectSessionGroupBy](ctx, csgb.build, csgb, csgb.build.inters, v)
+}
+
+func (csgb *ConnectSessionGroupBy) sqlScan(ctx context.Context, root *ConnectSessionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(csgb.fns))
+	for _, fn := range csgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*csgb.flds)+len(csgb.fns))
+		for _, f := range *csgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*csgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConnectSessionSelect is the builder for selecting fields of ConnectSession entities.
+type ConnectSessionSelect struct {
+	*ConnectSessionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (css *ConnectSessionSelect) Aggregate(fns ...AggregateFunc) *ConnectSessionSelect {
+	css.fns = append(css.fns, fns...)
+	return css
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (css *ConnectSessionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, css.ctx, ent.OpQuerySelect)
+	if err := css.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConnectSessionQuery, *ConnectSessionSelect](ctx, css.ConnectSessionQuery, css, css.inters, v)
+}
+
+func (css *ConnectSessionSelect) sqlScan(ctx context.Context, root *ConnectSessionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(css.fns))
+	for _, fn := range css.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*css.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := css.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
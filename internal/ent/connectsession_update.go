@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/connectsession"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+)
+
+// ConnectSessionUpdate is the builder for updating ConnectSession entities.
+type ConnectSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConnectSessionMutation
+}
+
+// Where appends a list predicates to the ConnectSessionUpdate builder.
+func (csu *ConnectSessionUpdate) Where(ps ...predicate.ConnectSession) *ConnectSessionUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// Mutation returns the ConnectSessionMutation object of the builder.
+func (csu *ConnectSessionUpdate) Mutation() *ConnectSessionMutation {
+	return csu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *ConnectSessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, csu.sqlSave, csu.mutation, csu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *ConnectSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *ConnectSessionUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *ConnectSessionUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (csu *ConnectSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(connectsession.Table, connectsession.Columns, sqlgraph.NewFieldSpec(connectsession.FieldID, field.TypeString))
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if csu.mutation.InfluencerIDCleared() {
+		_spec.ClearField(connectsession.FieldInfluencerID, field.TypeString)
+	}
+	if csu.mutation.NameCleared() {
+		_spec.ClearField(connectsession.FieldName, field.TypeString)
+	}
+	if csu.mutation.RedirectURLCleared() {
+		_spec.ClearField(connectsession.FieldRedirectURL, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{connectsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	csu.mutation.done = true
+	return n, nil
+}
+
+// ConnectSessionUpdateOne is the builder for updating a single ConnectSession entity.
+type ConnectSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConnectSessionMutation
+}
+
+// Mutation returns the ConnectSessionMutation object of the builder.
+func (csuo *ConnectSessionUpdateOne) Mutation() *ConnectSessionMutation {
+	return csuo.mutation
+}
+
+// Where appends a list predicates to the ConnectSessionUpdate builder.
+func (csuo *ConnectSessionUpdateOne) Where(ps ...predicate.ConnectSession) *ConnectSessionUpdateOne {
+	csuo.mutation.Where(ps...)
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *ConnectSessionUpdateOne) Select(field string, fields ...string) *ConnectSessionUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated ConnectSession entity.
+func (csuo *ConnectSessionUpdateOne) Save(ctx context.Context) (*ConnectSession, error) {
+	return withHooks(ctx, csuo.sqlSave, csuo.mutation, csuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *ConnectSessionUpdateOne) SaveX(ctx context.Context) *ConnectSession {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *ConnectSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *ConnectSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (csuo *ConnectSessionUpdateOne) sqlSave(ctx context.Context) (_node *ConnectSession, err error) {
+	_spec := sqlgraph.NewUpdateSpec(connectsession.Table, connectsession.Columns, sqlgraph.NewFieldSpec(connectsession.FieldID, field.TypeString))
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConnectSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, connectsession.FieldID)
+		for _, f := range fields {
+			if !connectsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != connectsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if csuo.mutation.InfluencerIDCleared() {
+		_spec.ClearField(connectsession.FieldInfluencerID, field.TypeString)
+	}
+	if csuo.mutation.NameCleared() {
+		_spec.ClearField(connectsession.FieldName, field.TypeString)
+	}
+	if csuo.mutation.RedirectURLCleared() {
+		_spec.ClearField(connectsession.FieldRedirectURL, field.TypeString)
+	}
+	_node = &ConnectSession{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{connectsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	csuo.mutation.done = true
+	return _node, nil
+}
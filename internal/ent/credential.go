@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+)
+
+// Credential is the model entity for the Credential schema.
+type Credential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// InfluencerID holds the value of the "influencer_id" field.
+	InfluencerID string `json:"influencer_id,omitempty"`
+	// Ciphertext holds the value of the "ciphertext" field.
+	Ciphertext []byte `json:"-"`
+	// WrappedKey holds the value of the "wrapped_key" field.
+	WrappedKey []byte `json:"-"`
+	// KeyVersion holds the value of the "key_version" field.
+	KeyVersion int `json:"key_version,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CredentialQuery when eager-loading is set.
+	Edges        CredentialEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CredentialEdges holds the relations/edges for other nodes in the graph.
+type CredentialEdges struct {
+	// Influencer holds the value of the influencer edge.
+	Influencer *Influencer `json:"influencer,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// InfluencerOrErr returns the Influencer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CredentialEdges) InfluencerOrErr() (*Influencer, error) {
+	if e.Influencer != nil {
+		return e.Influencer, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: influencer.Label}
+	}
+	return nil, &NotLoadedError{edge: "influencer"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Credential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case credential.FieldCiphertext, credential.FieldWrappedKey:
+			values[i] = new([]byte)
+		case credential.FieldKeyVersion:
+			values[i] = new(sql.NullInt64)
+		case credential.FieldID, credential.FieldInfluencerID:
+			values[i] = new(sql.NullString)
+		case credential.FieldExpiresAt, credential.FieldCreatedAt, credential.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Credential fields.
+func (c *Credential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case credential.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				c.ID = value.String
+			}
+		case credential.FieldInfluencerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field influencer_id", values[i])
+			} else if value.Valid {
+				c.InfluencerID = value.String
+			}
+		case credential.FieldCiphertext:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ciphertext", values[i])
+			} else if value != nil {
+				c.Ciphertext = *value
+			}
+		case credential.FieldWrappedKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field wrapped_key", values[i])
+			} else if value != nil {
+				c.WrappedKey = *value
+			}
+		case credential.FieldKeyVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field key_version", values[i])
+			} else if value.Valid {
+				c.KeyVersion = int(value.Int64)
+			}
+		case credential.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				c.ExpiresAt = new(time.Time)
+				*c.ExpiresAt = value.Time
+			}
+		case credential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case credential.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Credential.
+// This includes values selected through modifiers, order, etc.
+func (c *Credential) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryInfluencer queries the "influencer" edge of the Credential entity.
+func (c *Credential) QueryInfluencer() *InfluencerQuery {
+	return NewCredentialClient(c.config).QueryInfluencer(c)
+}
+
+// Update returns a builder for updating this Credential.
+// Note that you need to call Credential.Unwrap() before calling this method if this Credential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Credential) Update() *CredentialUpdateOne {
+	return NewCredentialClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Credential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Credential) Unwrap() *Credential {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Credential is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Credential) String() string {
+	var builder strings.Builder
+	builder.WriteString("Credential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("influencer_id=")
+	builder.WriteString(c.InfluencerID)
+	builder.WriteString(", ")
+	builder.WriteString("ciphertext=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("wrapped_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("key_version=")
+	builder.WriteString(fmt.Sprintf("%v", c.KeyVersion))
+	builder.WriteString(", ")
+	if v := c.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Credentials is a parsable slice of Credential.
+type Credentials []*Credential
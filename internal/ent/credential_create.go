@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+)
+
+// CredentialCreate is the builder for creating a Credential entity.
+type CredentialCreate struct {
+	config
+	mutation *CredentialMutation
+	hooks    []Hook
+}
+
+// SetInfluencerID sets the "influencer_id" field.
+func (cc *CredentialCreate) SetInfluencerID(s string) *CredentialCreate {
+	cc.mutation.SetInfluencerID(s)
+	return cc
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (cc *CredentialCreate) SetCiphertext(b []byte) *CredentialCreate {
+	cc.mutation.SetCiphertext(b)
+	return cc
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (cc *CredentialCreate) SetWrappedKey(b []byte) *CredentialCreate {
+	cc.mutation.SetWrappedKey(b)
+	return cc
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (cc *CredentialCreate) SetKeyVersion(i int) *CredentialCreate {
+	cc.mutation.SetKeyVersion(i)
+	return cc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cc *CredentialCreate) SetExpiresAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetExpiresAt(t)
+	return cc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableExpiresAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetExpiresAt(*t)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CredentialCreate) SetCreatedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableCreatedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CredentialCreate) SetUpdatedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableUpdatedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CredentialCreate) SetID(s string) *CredentialCreate {
+	cc.mutation.SetID(s)
+	return cc
+}
+
+// SetInfluencer sets the "influencer" edge to the Influencer entity.
+func (cc *CredentialCreate) SetInfluencer(i *Influencer) *CredentialCreate {
+	return cc.SetInfluencerID(i.ID)
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cc *CredentialCreate) Mutation() *CredentialMutation {
+	return cc.mutation
+}
+
+// Save creates the Credential in the database.
+func (cc *CredentialCreate) Save(ctx context.Context) (*Credential, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CredentialCreate) SaveX(ctx context.Context) *Credential {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CredentialCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CredentialCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CredentialCreate) defaults() {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := credential.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := credential.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CredentialCreate) check() error {
+	if _, ok := cc.mutation.InfluencerID(); !ok {
+		return &ValidationError{Name: "influencer_id", err: errors.New(`ent: missing required field "Credential.influencer_id"`)}
+	}
+	if _, ok := cc.mutation.Ciphertext(); !ok {
+		return &ValidationError{Name: "ciphertext", err: errors.New(`ent: missing required field "Credential.ciphertext"`)}
+	}
+	if _, ok := cc.mutation.WrappedKey(); !ok {
+		return &ValidationError{Name: "wrapped_key", err: errors.New(`ent: missing required field "Credential.wrapped_key"`)}
+	}
+	if _, ok := cc.mutation.KeyVersion(); !ok {
+		return &ValidationError{Name: "key_version", err: errors.New(`ent: missing required field "Credential.key_version"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Credential.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Credential.updated_at"`)}
+	}
+	if len(cc.mutation.InfluencerIDs()) == 0 {
+		return &ValidationError{Name: "influencer", err: errors.New(`ent: missing required edge "Credential.influencer"`)}
+	}
+	return nil
+}
+
+func (cc *CredentialCreate) sqlSave(ctx context.Context) (*Credential, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Credential.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CredentialCreate) createSpec() (*Credential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Credential{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(credential.Table, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeString))
+	)
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cc.mutation.Ciphertext(); ok {
+		_spec.SetField(credential.FieldCiphertext, field.TypeBytes, value)
+		_node.Ciphertext = value
+	}
+	if value, ok := cc.mutation.WrappedKey(); ok {
+		_spec.SetField(credential.FieldWrappedKey, field.TypeBytes, value)
+		_node.WrappedKey = value
+	}
+	if value, ok := cc.mutation.KeyVersion(); ok {
+		_spec.SetField(credential.FieldKeyVersion, field.TypeInt, value)
+		_node.KeyVersion = value
+	}
+	if value, ok := cc.mutation.ExpiresAt(); ok {
+		_spec.SetField(credential.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(credential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(credential.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := cc.mutation.InfluencerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   credential.InfluencerTable,
+			Columns: []string{credential.InfluencerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(influencer.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InfluencerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CredentialCreateBulk is the builder for creating many Credential entities in bulk.
+type CredentialCreateBulk struct {
+	config
+	err      error
+	builders []*CredentialCreate
+}
+
+// Save creates the Credential entities in the database.
+func (ccb *CredentialCreateBulk) Save(ctx context.Context) ([]*Credential, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Credential, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CredentialCreateBulk) SaveX(ctx context.Context) []*Credential {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
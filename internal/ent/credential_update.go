@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+)
+
+// CredentialUpdate is the builder for updating Credential entities.
+type CredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CredentialMutation
+}
+
+// Where appends a list predicates to the CredentialUpdate builder.
+func (cu *CredentialUpdate) Where(ps ...predicate.Credential) *CredentialUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetInfluencerID sets the "influencer_id" field.
+func (cu *CredentialUpdate) SetInfluencerID(s string) *CredentialUpdate {
+	cu.mutation.SetInfluencerID(s)
+	return cu
+}
+
+// SetNillableInfluencerID sets the "influencer_id" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableInfluencerID(s *string) *CredentialUpdate {
+	if s != nil {
+		cu.SetInfluencerID(*s)
+	}
+	return cu
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (cu *CredentialUpdate) SetCiphertext(b []byte) *CredentialUpdate {
+	cu.mutation.SetCiphertext(b)
+	return cu
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (cu *CredentialUpdate) SetWrappedKey(b []byte) *CredentialUpdate {
+	cu.mutation.SetWrappedKey(b)
+	return cu
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (cu *CredentialUpdate) SetKeyVersion(i int) *CredentialUpdate {
+	cu.mutation.ResetKeyVersion()
+	cu.mutation.SetKeyVersion(i)
+	return cu
+}
+
+// SetNillableKeyVersion sets the "key_version" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableKeyVersion(i *int) *CredentialUpdate {
+	if i != nil {
+		cu.SetKeyVersion(*i)
+	}
+	return cu
+}
+
+// AddKeyVersion adds i to the "key_version" field.
+func (cu *CredentialUpdate) AddKeyVersion(i int) *CredentialUpdate {
+	cu.mutation.AddKeyVersion(i)
+	return cu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cu *CredentialUpdate) SetExpiresAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetExpiresAt(t)
+	return cu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableExpiresAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetExpiresAt(*t)
+	}
+	return cu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cu *CredentialUpdate) ClearExpiresAt() *CredentialUpdate {
+	cu.mutation.ClearExpiresAt()
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CredentialUpdate) SetUpdatedAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetInfluencer sets the "influencer" edge to the Influencer entity.
+func (cu *CredentialUpdate) SetInfluencer(i *Influencer) *CredentialUpdate {
+	return cu.SetInfluencerID(i.ID)
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cu *CredentialUpdate) Mutation() *CredentialMutation {
+	return cu.mutation
+}
+
+// ClearInfluencer clears the "influencer" edge to the Influencer entity.
+func (cu *CredentialUpdate) ClearInfluencer() *CredentialUpdate {
+	cu.mutation.ClearInfluencer()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CredentialUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CredentialUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CredentialUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CredentialUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := credential.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CredentialUpdate) check() error {
+	if cu.mutation.InfluencerCleared() && len(cu.mutation.InfluencerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Credential.influencer"`)
+	}
+	return nil
+}
+
+func (cu *CredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(credential.Table, credential.Columns, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeString))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Ciphertext(); ok {
+		_spec.SetField(credential.FieldCiphertext, field.TypeBytes, value)
+	}
+	if value, ok := cu.mutation.WrappedKey(); ok {
+		_spec.SetField(credential.FieldWrappedKey, field.TypeBytes, value)
+	}
+	if value, ok := cu.mutation.KeyVersion(); ok {
+		_spec.SetField(credential.FieldKeyVersion, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedKeyVersion(); ok {
+		_spec.AddField(credential.FieldKeyVersion, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.ExpiresAt(); ok {
+		_spec.SetField(credential.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(credential.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(credential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cu.mutation.InfluencerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   credential.InfluencerTable,
+			Columns: []string{credential.InfluencerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(influencer.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.InfluencerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   credential.InfluencerTable,
+			Columns: []string{credential.InfluencerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(influencer.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CredentialUpdateOne is the builder for updating a single Credential entity.
+type CredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CredentialMutation
+}
+
+// SetInfluencerID sets the "influencer_id" field.
+func (cuo *CredentialUpdateOne) SetInfluencerID(s string) *CredentialUpdateOne {
+	cuo.mutation.SetInfluencerID(s)
+	return cuo
+}
+
+// SetNillableInfluencerID sets the "influencer_id" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableInfluencerID(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetInfluencerID(*s)
+	}
+	return cuo
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (cuo *CredentialUpdateOne) SetCiphertext(b []byte) *CredentialUpdateOne {
+	cuo.mutation.SetCiphertext(b)
+	return cuo
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (cuo *CredentialUpdateOne) SetWrappedKey(b []byte) *CredentialUpdateOne {
+	cuo.mutation.SetWrappedKey(b)
+	return cuo
+}
+
+// SetKeyVersion sets the "key_version" field.
+func (cuo *CredentialUpdateOne) SetKeyVersion(i int) *CredentialUpdateOne {
+	cuo.mutation.ResetKeyVersion()
+	cuo.mutation.SetKeyVersion(i)
+	return cuo
+}
+
+// SetNillableKeyVersion sets the "key_version" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableKeyVersion(i *int) *CredentialUpdateOne {
+	if i != nil {
+		cuo.SetKeyVersion(*i)
+	}
+	return cuo
+}
+
+// AddKeyVersion adds i to the "key_version" field.
+func (cuo *CredentialUpdateOne) AddKeyVersion(i int) *CredentialUpdateOne {
+	cuo.mutation.AddKeyVersion(i)
+	return cuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cuo *CredentialUpdateOne) SetExpiresAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetExpiresAt(t)
+	return cuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableExpiresAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetExpiresAt(*t)
+	}
+	return cuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cuo *CredentialUpdateOne) ClearExpiresAt() *CredentialUpdateOne {
+	cuo.mutation.ClearExpiresAt()
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CredentialUpdateOne) SetUpdatedAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetInfluencer sets the "influencer" edge to the Influencer entity.
+func (cuo *CredentialUpdateOne) SetInfluencer(i *Influencer) *CredentialUpdateOne {
+	return cuo.SetInfluencerID(i.ID)
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cuo *CredentialUpdateOne) Mutation() *CredentialMutation {
+	return cuo.mutation
+}
+
+// ClearInfluencer clears the "influencer" edge to the Influencer entity.
+func (cuo *CredentialUpdateOne) ClearInfluencer() *CredentialUpdateOne {
+	cuo.mutation.ClearInfluencer()
+	return cuo
+}
+
+// Where appends a list predicates to the CredentialUpdate builder.
+func (cuo *CredentialUpdateOne) Where(ps ...predicate.Credential) *CredentialUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CredentialUpdateOne) Select(field string, fields ...string) *CredentialUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Credential entity.
+func (cuo *CredentialUpdateOne) Save(ctx context.Context) (*Credential, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CredentialUpdateOne) SaveX(ctx context.Context) *Credential {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CredentialUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := credential.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CredentialUpdateOne) check() error {
+	if cuo.mutation.InfluencerCleared() && len(cuo.mutation.InfluencerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Credential.influencer"`)
+	}
+	return nil
+}
+
+func (cuo *CredentialUpdateOne) sqlSave(ctx context.Context) (_node *Credential, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(credential.Table, credential.Columns, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeString))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Credential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credential.FieldID)
+		for _, f := range fields {
+			if !credential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != credential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Ciphertext(); ok {
+		_spec.SetField(credential.FieldCiphertext, field.TypeBytes, value)
+	}
+	if value, ok := cuo.mutation.WrappedKey(); ok {
+		_spec.SetField(credential.FieldWrappedKey, field.TypeBytes, value)
+	}
+	if value, ok := cuo.mutation.KeyVersion(); ok {
+		_spec.SetField(credential.FieldKeyVersion, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedKeyVersion(); ok {
+		_spec.AddField(credential.FieldKeyVersion, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(credential.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(credential.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(credential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.InfluencerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   credential.InfluencerTable,
+			Columns: []string{credential.InfluencerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(influencer.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.InfluencerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   credential.InfluencerTable,
+			Columns: []string{credential.InfluencerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(influencer.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Credential{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/auditevent"
+	"github.com/WuPinYi/SocialForge/internal/ent/connectsession"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+	"github.com/WuPinYi/SocialForge/internal/ent/idempotencykey"
 	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/membership"
+	"github.com/WuPinYi/SocialForge/internal/ent/notification"
+	"github.com/WuPinYi/SocialForge/internal/ent/organization"
 	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/ent/serviceaccount"
 	"github.com/WuPinYi/SocialForge/internal/ent/user"
 )
 
@@ -75,9 +84,18 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			influencer.Table: influencer.ValidColumn,
-			post.Table:       post.ValidColumn,
-			user.Table:       user.ValidColumn,
+			accessgrant.Table:    accessgrant.ValidColumn,
+			auditevent.Table:     auditevent.ValidColumn,
+			connectsession.Table: connectsession.ValidColumn,
+			credential.Table:     credential.ValidColumn,
+			idempotencykey.Table: idempotencykey.ValidColumn,
+			influencer.Table:     influencer.ValidColumn,
+			membership.Table:     membership.ValidColumn,
+			notification.Table:   notification.ValidColumn,
+			organization.Table:   organization.ValidColumn,
+			post.Table:           post.ValidColumn,
+			serviceaccount.Table: serviceaccount.ValidColumn,
+			user.Table:           user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
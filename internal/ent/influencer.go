@@ -9,7 +9,9 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
 	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/organization"
 	"github.com/WuPinYi/SocialForge/internal/ent/user"
 )
 
@@ -18,6 +20,8 @@ type Influencer struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Platform holds the value of the "platform" field.
@@ -26,6 +30,12 @@ type Influencer struct {
 	AccountID string `json:"account_id,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
+	// DisconnectReason holds the value of the "disconnect_reason" field.
+	DisconnectReason string `json:"disconnect_reason,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID string `json:"organization_id,omitempty"`
+	// AccountVerifiedAt holds the value of the "account_verified_at" field.
+	AccountVerifiedAt *time.Time `json:"account_verified_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -41,11 +51,17 @@ type Influencer struct {
 type InfluencerEdges struct {
 	// Owner holds the value of the owner edge.
 	Owner *User `json:"owner,omitempty"`
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// Posts holds the value of the posts edge.
 	Posts []*Post `json:"posts,omitempty"`
+	// AccessGrants holds the value of the access_grants edge.
+	AccessGrants []*AccessGrant `json:"access_grants,omitempty"`
+	// Credential holds the value of the credential edge.
+	Credential *Credential `json:"credential,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -59,23 +75,54 @@ func (e InfluencerEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InfluencerEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
 // PostsOrErr returns the Posts value or an error if the edge
 // was not loaded in eager-loading.
 func (e InfluencerEdges) PostsOrErr() ([]*Post, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Posts, nil
 	}
 	return nil, &NotLoadedError{edge: "posts"}
 }
 
+// AccessGrantsOrErr returns the AccessGrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e InfluencerEdges) AccessGrantsOrErr() ([]*AccessGrant, error) {
+	if e.loadedTypes[3] {
+		return e.AccessGrants, nil
+	}
+	return nil, &NotLoadedError{edge: "access_grants"}
+}
+
+// CredentialOrErr returns the Credential value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InfluencerEdges) CredentialOrErr() (*Credential, error) {
+	if e.Credential != nil {
+		return e.Credential, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: credential.Label}
+	}
+	return nil, &NotLoadedError{edge: "credential"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Influencer) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case influencer.FieldID, influencer.FieldName, influencer.FieldPlatform, influencer.FieldAccountID, influencer.FieldStatus:
+		case influencer.FieldID, influencer.FieldName, influencer.FieldPlatform, influencer.FieldAccountID, influencer.FieldStatus, influencer.FieldDisconnectReason, influencer.FieldOrganizationID:
 			values[i] = new(sql.NullString)
-		case influencer.FieldCreatedAt, influencer.FieldUpdatedAt:
+		case influencer.FieldDeletedAt, influencer.FieldAccountVerifiedAt, influencer.FieldCreatedAt, influencer.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case influencer.ForeignKeys[0]: // user_influencers
 			values[i] = new(sql.NullString)
@@ -100,6 +147,13 @@ func (i *Influencer) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.ID = value.String
 			}
+		case influencer.FieldDeletedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[j])
+			} else if value.Valid {
+				i.DeletedAt = new(time.Time)
+				*i.DeletedAt = value.Time
+			}
 		case influencer.FieldName:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[j])
@@ -124,6 +178,25 @@ func (i *Influencer) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.Status = value.String
 			}
+		case influencer.FieldDisconnectReason:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field disconnect_reason", values[j])
+			} else if value.Valid {
+				i.DisconnectReason = value.String
+			}
+		case influencer.FieldOrganizationID:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[j])
+			} else if value.Valid {
+				i.OrganizationID = value.String
+			}
+		case influencer.FieldAccountVerifiedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field account_verified_at", values[j])
+			} else if value.Valid {
+				i.AccountVerifiedAt = new(time.Time)
+				*i.AccountVerifiedAt = value.Time
+			}
 		case influencer.FieldCreatedAt:
 			if value, ok := values[j].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[j])
@@ -161,11 +234,26 @@ func (i *Influencer) QueryOwner() *UserQuery {
 	return NewInfluencerClient(i.config).QueryOwner(i)
 }
 
+// QueryOrganization queries the "organization" edge of the Influencer entity.
+func (i *Influencer) QueryOrganization() *OrganizationQuery {
+	return NewInfluencerClient(i.config).QueryOrganization(i)
+}
+
 // QueryPosts queries the "posts" edge of the Influencer entity.
 func (i *Influencer) QueryPosts() *PostQuery {
 	return NewInfluencerClient(i.config).QueryPosts(i)
 }
 
+// QueryAccessGrants queries the "access_grants" edge of the Influencer entity.
+func (i *Influencer) QueryAccessGrants() *AccessGrantQuery {
+	return NewInfluencerClient(i.config).QueryAccessGrants(i)
+}
+
+// QueryCredential queries the "credential" edge of the Influencer entity.
+func (i *Influencer) QueryCredential() *CredentialQuery {
+	return NewInfluencerClient(i.config).QueryCredential(i)
+}
+
 // Update returns a builder for updating this Influencer.
 // Note that you need to call Influencer.Unwrap() before calling this method if this Influencer
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -189,6 +277,11 @@ func (i *Influencer) String() string {
 	var builder strings.Builder
 	builder.WriteString("Influencer(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	if v := i.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(i.Name)
 	builder.WriteString(", ")
@@ -201,6 +294,17 @@ func (i *Influencer) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(i.Status)
 	builder.WriteString(", ")
+	builder.WriteString("disconnect_reason=")
+	builder.WriteString(i.DisconnectReason)
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(i.OrganizationID)
+	builder.WriteString(", ")
+	if v := i.AccountVerifiedAt; v != nil {
+		builder.WriteString("account_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -65,6 +65,11 @@ func IDContainsFold(id string) predicate.Influencer {
 	return predicate.Influencer(sql.FieldContainsFold(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Influencer {
 	return predicate.Influencer(sql.FieldEQ(FieldName, v))
@@ -85,6 +90,21 @@ func Status(v string) predicate.Influencer {
 	return predicate.Influencer(sql.FieldEQ(FieldStatus, v))
 }
 
+// DisconnectReason applies equality check predicate on the "disconnect_reason" field. It's identical to DisconnectReasonEQ.
+func DisconnectReason(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldEQ(FieldDisconnectReason, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// AccountVerifiedAt applies equality check predicate on the "account_verified_at" field. It's identical to AccountVerifiedAtEQ.
+func AccountVerifiedAt(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldEQ(FieldAccountVerifiedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Influencer {
 	return predicate.Influencer(sql.FieldEQ(FieldCreatedAt, v))
@@ -95,6 +115,56 @@ func UpdatedAt(v time.Time) predicate.Influencer {
 	return predicate.Influencer(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Influencer {
+	return predicate.Influencer(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Influencer {
+	return predicate.Influencer(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Influencer {
 	return predicate.Influencer(sql.FieldEQ(FieldName, v))
@@ -280,6 +350,16 @@ func AccountIDHasSuffix(v string) predicate.Influencer {
 	return predicate.Influencer(sql.FieldHasSuffix(FieldAccountID, v))
 }
 
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.Influencer {
+	return predicate.Influencer(sql.FieldIsNull(FieldAccountID))
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.Influencer {
+	return predicate.Influencer(sql.FieldNotNull(FieldAccountID))
+}
+
 // AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
 func AccountIDEqualFold(v string) predicate.Influencer {
 	return predicate.Influencer(sql.FieldEqualFold(FieldAccountID, v))
@@ -355,6 +435,206 @@ func StatusContainsFold(v string) predicate.Influencer {
 	return predicate.Influencer(sql.FieldContainsFold(FieldStatus, v))
 }
 
+// DisconnectReasonEQ applies the EQ predicate on the "disconnect_reason" field.
+func DisconnectReasonEQ(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldEQ(FieldDisconnectReason, v))
+}
+
+// DisconnectReasonNEQ applies the NEQ predicate on the "disconnect_reason" field.
+func DisconnectReasonNEQ(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldNEQ(FieldDisconnectReason, v))
+}
+
+// DisconnectReasonIn applies the In predicate on the "disconnect_reason" field.
+func DisconnectReasonIn(vs ...string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldIn(FieldDisconnectReason, vs...))
+}
+
+// DisconnectReasonNotIn applies the NotIn predicate on the "disconnect_reason" field.
+func DisconnectReasonNotIn(vs ...string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldNotIn(FieldDisconnectReason, vs...))
+}
+
+// DisconnectReasonGT applies the GT predicate on the "disconnect_reason" field.
+func DisconnectReasonGT(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldGT(FieldDisconnectReason, v))
+}
+
+// DisconnectReasonGTE applies the GTE predicate on the "disconnect_reason" field.
+func DisconnectReasonGTE(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldGTE(FieldDisconnectReason, v))
+}
+
+// DisconnectReasonLT applies the LT predicate on the "disconnect_reason" field.
+func DisconnectReasonLT(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldLT(FieldDisconnectReason, v))
+}
+
+// DisconnectReasonLTE applies the LTE predicate on the "disconnect_reason" field.
+func DisconnectReasonLTE(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldLTE(FieldDisconnectReason, v))
+}
+
+// DisconnectReasonContains applies the Contains predicate on the "disconnect_reason" field.
+func DisconnectReasonContains(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldContains(FieldDisconnectReason, v))
+}
+
+// DisconnectReasonHasPrefix applies the HasPrefix predicate on the "disconnect_reason" field.
+func DisconnectReasonHasPrefix(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldHasPrefix(FieldDisconnectReason, v))
+}
+
+// DisconnectReasonHasSuffix applies the HasSuffix predicate on the "disconnect_reason" field.
+func DisconnectReasonHasSuffix(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldHasSuffix(FieldDisconnectReason, v))
+}
+
+// DisconnectReasonIsNil applies the IsNil predicate on the "disconnect_reason" field.
+func DisconnectReasonIsNil() predicate.Influencer {
+	return predicate.Influencer(sql.FieldIsNull(FieldDisconnectReason))
+}
+
+// DisconnectReasonNotNil applies the NotNil predicate on the "disconnect_reason" field.
+func DisconnectReasonNotNil() predicate.Influencer {
+	return predicate.Influencer(sql.FieldNotNull(FieldDisconnectReason))
+}
+
+// DisconnectReasonEqualFold applies the EqualFold predicate on the "disconnect_reason" field.
+func DisconnectReasonEqualFold(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldEqualFold(FieldDisconnectReason, v))
+}
+
+// DisconnectReasonContainsFold applies the ContainsFold predicate on the "disconnect_reason" field.
+func DisconnectReasonContainsFold(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldContainsFold(FieldDisconnectReason, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDContains applies the Contains predicate on the "organization_id" field.
+func OrganizationIDContains(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldContains(FieldOrganizationID, v))
+}
+
+// OrganizationIDHasPrefix applies the HasPrefix predicate on the "organization_id" field.
+func OrganizationIDHasPrefix(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldHasPrefix(FieldOrganizationID, v))
+}
+
+// OrganizationIDHasSuffix applies the HasSuffix predicate on the "organization_id" field.
+func OrganizationIDHasSuffix(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldHasSuffix(FieldOrganizationID, v))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.Influencer {
+	return predicate.Influencer(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.Influencer {
+	return predicate.Influencer(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// OrganizationIDEqualFold applies the EqualFold predicate on the "organization_id" field.
+func OrganizationIDEqualFold(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldEqualFold(FieldOrganizationID, v))
+}
+
+// OrganizationIDContainsFold applies the ContainsFold predicate on the "organization_id" field.
+func OrganizationIDContainsFold(v string) predicate.Influencer {
+	return predicate.Influencer(sql.FieldContainsFold(FieldOrganizationID, v))
+}
+
+// AccountVerifiedAtEQ applies the EQ predicate on the "account_verified_at" field.
+func AccountVerifiedAtEQ(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldEQ(FieldAccountVerifiedAt, v))
+}
+
+// AccountVerifiedAtNEQ applies the NEQ predicate on the "account_verified_at" field.
+func AccountVerifiedAtNEQ(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldNEQ(FieldAccountVerifiedAt, v))
+}
+
+// AccountVerifiedAtIn applies the In predicate on the "account_verified_at" field.
+func AccountVerifiedAtIn(vs ...time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldIn(FieldAccountVerifiedAt, vs...))
+}
+
+// AccountVerifiedAtNotIn applies the NotIn predicate on the "account_verified_at" field.
+func AccountVerifiedAtNotIn(vs ...time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldNotIn(FieldAccountVerifiedAt, vs...))
+}
+
+// AccountVerifiedAtGT applies the GT predicate on the "account_verified_at" field.
+func AccountVerifiedAtGT(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldGT(FieldAccountVerifiedAt, v))
+}
+
+// AccountVerifiedAtGTE applies the GTE predicate on the "account_verified_at" field.
+func AccountVerifiedAtGTE(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldGTE(FieldAccountVerifiedAt, v))
+}
+
+// AccountVerifiedAtLT applies the LT predicate on the "account_verified_at" field.
+func AccountVerifiedAtLT(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldLT(FieldAccountVerifiedAt, v))
+}
+
+// AccountVerifiedAtLTE applies the LTE predicate on the "account_verified_at" field.
+func AccountVerifiedAtLTE(v time.Time) predicate.Influencer {
+	return predicate.Influencer(sql.FieldLTE(FieldAccountVerifiedAt, v))
+}
+
+// AccountVerifiedAtIsNil applies the IsNil predicate on the "account_verified_at" field.
+func AccountVerifiedAtIsNil() predicate.Influencer {
+	return predicate.Influencer(sql.FieldIsNull(FieldAccountVerifiedAt))
+}
+
+// AccountVerifiedAtNotNil applies the NotNil predicate on the "account_verified_at" field.
+func AccountVerifiedAtNotNil() predicate.Influencer {
+	return predicate.Influencer(sql.FieldNotNull(FieldAccountVerifiedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Influencer {
 	return predicate.Influencer(sql.FieldEQ(FieldCreatedAt, v))
@@ -458,6 +738,29 @@ func HasOwnerWith(preds ...predicate.User) predicate.Influencer {
 	})
 }
 
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.Influencer {
+	return predicate.Influencer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.Influencer {
+	return predicate.Influencer(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasPosts applies the HasEdge predicate on the "posts" edge.
 func HasPosts() predicate.Influencer {
 	return predicate.Influencer(func(s *sql.Selector) {
@@ -481,6 +784,52 @@ func HasPostsWith(preds ...predicate.Post) predicate.Influencer {
 	})
 }
 
+// HasAccessGrants applies the HasEdge predicate on the "access_grants" edge.
+func HasAccessGrants() predicate.Influencer {
+	return predicate.Influencer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccessGrantsTable, AccessGrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessGrantsWith applies the HasEdge predicate on the "access_grants" edge with a given conditions (other predicates).
+func HasAccessGrantsWith(preds ...predicate.AccessGrant) predicate.Influencer {
+	return predicate.Influencer(func(s *sql.Selector) {
+		step := newAccessGrantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCredential applies the HasEdge predicate on the "credential" edge.
+func HasCredential() predicate.Influencer {
+	return predicate.Influencer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, CredentialTable, CredentialColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCredentialWith applies the HasEdge predicate on the "credential" edge with a given conditions (other predicates).
+func HasCredentialWith(preds ...predicate.Credential) predicate.Influencer {
+	return predicate.Influencer(func(s *sql.Selector) {
+		step := newCredentialStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Influencer) predicate.Influencer {
 	return predicate.Influencer(sql.AndPredicates(predicates...))
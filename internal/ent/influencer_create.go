@@ -10,7 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
 	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/organization"
 	"github.com/WuPinYi/SocialForge/internal/ent/post"
 	"github.com/WuPinYi/SocialForge/internal/ent/user"
 )
@@ -22,6 +25,20 @@ type InfluencerCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ic *InfluencerCreate) SetDeletedAt(t time.Time) *InfluencerCreate {
+	ic.mutation.SetDeletedAt(t)
+	return ic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ic *InfluencerCreate) SetNillableDeletedAt(t *time.Time) *InfluencerCreate {
+	if t != nil {
+		ic.SetDeletedAt(*t)
+	}
+	return ic
+}
+
 // SetName sets the "name" field.
 func (ic *InfluencerCreate) SetName(s string) *InfluencerCreate {
 	ic.mutation.SetName(s)
@@ -40,6 +57,14 @@ func (ic *InfluencerCreate) SetAccountID(s string) *InfluencerCreate {
 	return ic
 }
 
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (ic *InfluencerCreate) SetNillableAccountID(s *string) *InfluencerCreate {
+	if s != nil {
+		ic.SetAccountID(*s)
+	}
+	return ic
+}
+
 // SetStatus sets the "status" field.
 func (ic *InfluencerCreate) SetStatus(s string) *InfluencerCreate {
 	ic.mutation.SetStatus(s)
@@ -54,6 +79,48 @@ func (ic *InfluencerCreate) SetNillableStatus(s *string) *InfluencerCreate {
 	return ic
 }
 
+// SetDisconnectReason sets the "disconnect_reason" field.
+func (ic *InfluencerCreate) SetDisconnectReason(s string) *InfluencerCreate {
+	ic.mutation.SetDisconnectReason(s)
+	return ic
+}
+
+// SetNillableDisconnectReason sets the "disconnect_reason" field if the given value is not nil.
+func (ic *InfluencerCreate) SetNillableDisconnectReason(s *string) *InfluencerCreate {
+	if s != nil {
+		ic.SetDisconnectReason(*s)
+	}
+	return ic
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (ic *InfluencerCreate) SetOrganizationID(s string) *InfluencerCreate {
+	ic.mutation.SetOrganizationID(s)
+	return ic
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (ic *InfluencerCreate) SetNillableOrganizationID(s *string) *InfluencerCreate {
+	if s != nil {
+		ic.SetOrganizationID(*s)
+	}
+	return ic
+}
+
+// SetAccountVerifiedAt sets the "account_verified_at" field.
+func (ic *InfluencerCreate) SetAccountVerifiedAt(t time.Time) *InfluencerCreate {
+	ic.mutation.SetAccountVerifiedAt(t)
+	return ic
+}
+
+// SetNillableAccountVerifiedAt sets the "account_verified_at" field if the given value is not nil.
+func (ic *InfluencerCreate) SetNillableAccountVerifiedAt(t *time.Time) *InfluencerCreate {
+	if t != nil {
+		ic.SetAccountVerifiedAt(*t)
+	}
+	return ic
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ic *InfluencerCreate) SetCreatedAt(t time.Time) *InfluencerCreate {
 	ic.mutation.SetCreatedAt(t)
@@ -99,6 +166,11 @@ func (ic *InfluencerCreate) SetOwner(u *User) *InfluencerCreate {
 	return ic.SetOwnerID(u.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (ic *InfluencerCreate) SetOrganization(o *Organization) *InfluencerCreate {
+	return ic.SetOrganizationID(o.ID)
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (ic *InfluencerCreate) AddPostIDs(ids ...string) *InfluencerCreate {
 	ic.mutation.AddPostIDs(ids...)
@@ -114,6 +186,40 @@ func (ic *InfluencerCreate) AddPosts(p ...*Post) *InfluencerCreate {
 	return ic.AddPostIDs(ids...)
 }
 
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (ic *InfluencerCreate) AddAccessGrantIDs(ids ...string) *InfluencerCreate {
+	ic.mutation.AddAccessGrantIDs(ids...)
+	return ic
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (ic *InfluencerCreate) AddAccessGrants(a ...*AccessGrant) *InfluencerCreate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ic.AddAccessGrantIDs(ids...)
+}
+
+// SetCredentialID sets the "credential" edge to the Credential entity by ID.
+func (ic *InfluencerCreate) SetCredentialID(id string) *InfluencerCreate {
+	ic.mutation.SetCredentialID(id)
+	return ic
+}
+
+// SetNillableCredentialID sets the "credential" edge to the Credential entity by ID if the given value is not nil.
+func (ic *InfluencerCreate) SetNillableCredentialID(id *string) *InfluencerCreate {
+	if id != nil {
+		ic = ic.SetCredentialID(*id)
+	}
+	return ic
+}
+
+// SetCredential sets the "credential" edge to the Credential entity.
+func (ic *InfluencerCreate) SetCredential(c *Credential) *InfluencerCreate {
+	return ic.SetCredentialID(c.ID)
+}
+
 // Mutation returns the InfluencerMutation object of the builder.
 func (ic *InfluencerCreate) Mutation() *InfluencerMutation {
 	return ic.mutation
@@ -171,9 +277,6 @@ func (ic *InfluencerCreate) check() error {
 	if _, ok := ic.mutation.Platform(); !ok {
 		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "Influencer.platform"`)}
 	}
-	if _, ok := ic.mutation.AccountID(); !ok {
-		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Influencer.account_id"`)}
-	}
 	if _, ok := ic.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Influencer.status"`)}
 	}
@@ -221,6 +324,10 @@ func (ic *InfluencerCreate) createSpec() (*Influencer, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := ic.mutation.DeletedAt(); ok {
+		_spec.SetField(influencer.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := ic.mutation.Name(); ok {
 		_spec.SetField(influencer.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -237,6 +344,14 @@ func (ic *InfluencerCreate) createSpec() (*Influencer, *sqlgraph.CreateSpec) {
 		_spec.SetField(influencer.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := ic.mutation.DisconnectReason(); ok {
+		_spec.SetField(influencer.FieldDisconnectReason, field.TypeString, value)
+		_node.DisconnectReason = value
+	}
+	if value, ok := ic.mutation.AccountVerifiedAt(); ok {
+		_spec.SetField(influencer.FieldAccountVerifiedAt, field.TypeTime, value)
+		_node.AccountVerifiedAt = &value
+	}
 	if value, ok := ic.mutation.CreatedAt(); ok {
 		_spec.SetField(influencer.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -262,6 +377,23 @@ func (ic *InfluencerCreate) createSpec() (*Influencer, *sqlgraph.CreateSpec) {
 		_node.user_influencers = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ic.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   influencer.OrganizationTable,
+			Columns: []string{influencer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ic.mutation.PostsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -278,6 +410,38 @@ func (ic *InfluencerCreate) createSpec() (*Influencer, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ic.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   influencer.AccessGrantsTable,
+			Columns: []string{influencer.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   influencer.CredentialTable,
+			Columns: []string{influencer.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
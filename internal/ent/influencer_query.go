@@ -12,7 +12,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
 	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/organization"
 	"github.com/WuPinYi/SocialForge/internal/ent/post"
 	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
 	"github.com/WuPinYi/SocialForge/internal/ent/user"
@@ -21,13 +24,16 @@ import (
 // InfluencerQuery is the builder for querying Influencer entities.
 type InfluencerQuery struct {
 	config
-	ctx        *QueryContext
-	order      []influencer.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Influencer
-	withOwner  *UserQuery
-	withPosts  *PostQuery
-	withFKs    bool
+	ctx              *QueryContext
+	order            []influencer.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Influencer
+	withOwner        *UserQuery
+	withOrganization *OrganizationQuery
+	withPosts        *PostQuery
+	withAccessGrants *AccessGrantQuery
+	withCredential   *CredentialQuery
+	withFKs          bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +92,28 @@ func (iq *InfluencerQuery) QueryOwner() *UserQuery {
 	return query
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (iq *InfluencerQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(influencer.Table, influencer.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, influencer.OrganizationTable, influencer.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryPosts chains the current query on the "posts" edge.
 func (iq *InfluencerQuery) QueryPosts() *PostQuery {
 	query := (&PostClient{config: iq.config}).Query()
@@ -108,6 +136,50 @@ func (iq *InfluencerQuery) QueryPosts() *PostQuery {
 	return query
 }
 
+// QueryAccessGrants chains the current query on the "access_grants" edge.
+func (iq *InfluencerQuery) QueryAccessGrants() *AccessGrantQuery {
+	query := (&AccessGrantClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(influencer.Table, influencer.FieldID, selector),
+			sqlgraph.To(accessgrant.Table, accessgrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, influencer.AccessGrantsTable, influencer.AccessGrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCredential chains the current query on the "credential" edge.
+func (iq *InfluencerQuery) QueryCredential() *CredentialQuery {
+	query := (&CredentialClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(influencer.Table, influencer.FieldID, selector),
+			sqlgraph.To(credential.Table, credential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, influencer.CredentialTable, influencer.CredentialColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Influencer entity from the query.
 // Returns a *NotFoundError when no Influencer was found.
 func (iq *InfluencerQuery) First(ctx context.Context) (*Influencer, error) {
@@ -295,13 +367,16 @@ func (iq *InfluencerQuery) Clone() *InfluencerQuery {
 		return nil
 	}
 	return &InfluencerQuery{
-		config:     iq.config,
-		ctx:        iq.ctx.Clone(),
-		order:      append([]influencer.OrderOption{}, iq.order...),
-		inters:     append([]Interceptor{}, iq.inters...),
-		predicates: append([]predicate.Influencer{}, iq.predicates...),
-		withOwner:  iq.withOwner.Clone(),
-		withPosts:  iq.withPosts.Clone(),
+		config:           iq.config,
+		ctx:              iq.ctx.Clone(),
+		order:            append([]influencer.OrderOption{}, iq.order...),
+		inters:           append([]Interceptor{}, iq.inters...),
+		predicates:       append([]predicate.Influencer{}, iq.predicates...),
+		withOwner:        iq.withOwner.Clone(),
+		withOrganization: iq.withOrganization.Clone(),
+		withPosts:        iq.withPosts.Clone(),
+		withAccessGrants: iq.withAccessGrants.Clone(),
+		withCredential:   iq.withCredential.Clone(),
 		// clone intermediate query.
 		sql:  iq.sql.Clone(),
 		path: iq.path,
@@ -319,6 +394,17 @@ func (iq *InfluencerQuery) WithOwner(opts ...func(*UserQuery)) *InfluencerQuery
 	return iq
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *InfluencerQuery) WithOrganization(opts ...func(*OrganizationQuery)) *InfluencerQuery {
+	query := (&OrganizationClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withOrganization = query
+	return iq
+}
+
 // WithPosts tells the query-builder to eager-load the nodes that are connected to
 // the "posts" edge. The optional arguments are used to configure the query builder of the edge.
 func (iq *InfluencerQuery) WithPosts(opts ...func(*PostQuery)) *InfluencerQuery {
@@ -330,18 +416,40 @@ func (iq *InfluencerQuery) WithPosts(opts ...func(*PostQuery)) *InfluencerQuery
 	return iq
 }
 
+// WithAccessGrants tells the query-builder to eager-load the nodes that are connected to
+// the "access_grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *InfluencerQuery) WithAccessGrants(opts ...func(*AccessGrantQuery)) *InfluencerQuery {
+	query := (&AccessGrantClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withAccessGrants = query
+	return iq
+}
+
+// WithCredential tells the query-builder to eager-load the nodes that are connected to
+// the "credential" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *InfluencerQuery) WithCredential(opts ...func(*CredentialQuery)) *InfluencerQuery {
+	query := (&CredentialClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withCredential = query
+	return iq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Influencer.Query().
-//		GroupBy(influencer.FieldName).
+//		GroupBy(influencer.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (iq *InfluencerQuery) GroupBy(field string, fields ...string) *InfluencerGroupBy {
@@ -359,11 +467,11 @@ func (iq *InfluencerQuery) GroupBy(field string, fields ...string) *InfluencerGr
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Influencer.Query().
-//		Select(influencer.FieldName).
+//		Select(influencer.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (iq *InfluencerQuery) Select(fields ...string) *InfluencerSelect {
 	iq.ctx.Fields = append(iq.ctx.Fields, fields...)
@@ -409,9 +517,12 @@ func (iq *InfluencerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*I
 		nodes       = []*Influencer{}
 		withFKs     = iq.withFKs
 		_spec       = iq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
 			iq.withOwner != nil,
+			iq.withOrganization != nil,
 			iq.withPosts != nil,
+			iq.withAccessGrants != nil,
+			iq.withCredential != nil,
 		}
 	)
 	if iq.withOwner != nil {
@@ -444,6 +555,12 @@ func (iq *InfluencerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*I
 			return nil, err
 		}
 	}
+	if query := iq.withOrganization; query != nil {
+		if err := iq.loadOrganization(ctx, query, nodes, nil,
+			func(n *Influencer, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := iq.withPosts; query != nil {
 		if err := iq.loadPosts(ctx, query, nodes,
 			func(n *Influencer) { n.Edges.Posts = []*Post{} },
@@ -451,6 +568,19 @@ func (iq *InfluencerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*I
 			return nil, err
 		}
 	}
+	if query := iq.withAccessGrants; query != nil {
+		if err := iq.loadAccessGrants(ctx, query, nodes,
+			func(n *Influencer) { n.Edges.AccessGrants = []*AccessGrant{} },
+			func(n *Influencer, e *AccessGrant) { n.Edges.AccessGrants = append(n.Edges.AccessGrants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withCredential; query != nil {
+		if err := iq.loadCredential(ctx, query, nodes, nil,
+			func(n *Influencer, e *Credential) { n.Edges.Credential = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -486,6 +616,35 @@ func (iq *InfluencerQuery) loadOwner(ctx context.Context, query *UserQuery, node
 	}
 	return nil
 }
+func (iq *InfluencerQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*Influencer, init func(*Influencer), assign func(*Influencer, *Organization)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Influencer)
+	for i := range nodes {
+		fk := nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (iq *InfluencerQuery) loadPosts(ctx context.Context, query *PostQuery, nodes []*Influencer, init func(*Influencer), assign func(*Influencer, *Post)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*Influencer)
@@ -516,6 +675,63 @@ func (iq *InfluencerQuery) loadPosts(ctx context.Context, query *PostQuery, node
 	}
 	return nil
 }
+func (iq *InfluencerQuery) loadAccessGrants(ctx context.Context, query *AccessGrantQuery, nodes []*Influencer, init func(*Influencer), assign func(*Influencer, *AccessGrant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Influencer)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accessgrant.FieldInfluencerID)
+	}
+	query.Where(predicate.AccessGrant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(influencer.AccessGrantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.InfluencerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "influencer_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *InfluencerQuery) loadCredential(ctx context.Context, query *CredentialQuery, nodes []*Influencer, init func(*Influencer), assign func(*Influencer, *Credential)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Influencer)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(credential.FieldInfluencerID)
+	}
+	query.Where(predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(influencer.CredentialColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.InfluencerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "influencer_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (iq *InfluencerQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := iq.querySpec()
@@ -542,6 +758,9 @@ func (iq *InfluencerQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if iq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(influencer.FieldOrganizationID)
+		}
 	}
 	if ps := iq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
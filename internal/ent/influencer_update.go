@@ -11,7 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
 	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/organization"
 	"github.com/WuPinYi/SocialForge/internal/ent/post"
 	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
 	"github.com/WuPinYi/SocialForge/internal/ent/user"
@@ -30,6 +33,26 @@ func (iu *InfluencerUpdate) Where(ps ...predicate.Influencer) *InfluencerUpdate
 	return iu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (iu *InfluencerUpdate) SetDeletedAt(t time.Time) *InfluencerUpdate {
+	iu.mutation.SetDeletedAt(t)
+	return iu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iu *InfluencerUpdate) SetNillableDeletedAt(t *time.Time) *InfluencerUpdate {
+	if t != nil {
+		iu.SetDeletedAt(*t)
+	}
+	return iu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iu *InfluencerUpdate) ClearDeletedAt() *InfluencerUpdate {
+	iu.mutation.ClearDeletedAt()
+	return iu
+}
+
 // SetName sets the "name" field.
 func (iu *InfluencerUpdate) SetName(s string) *InfluencerUpdate {
 	iu.mutation.SetName(s)
@@ -72,6 +95,12 @@ func (iu *InfluencerUpdate) SetNillableAccountID(s *string) *InfluencerUpdate {
 	return iu
 }
 
+// ClearAccountID clears the value of the "account_id" field.
+func (iu *InfluencerUpdate) ClearAccountID() *InfluencerUpdate {
+	iu.mutation.ClearAccountID()
+	return iu
+}
+
 // SetStatus sets the "status" field.
 func (iu *InfluencerUpdate) SetStatus(s string) *InfluencerUpdate {
 	iu.mutation.SetStatus(s)
@@ -86,6 +115,66 @@ func (iu *InfluencerUpdate) SetNillableStatus(s *string) *InfluencerUpdate {
 	return iu
 }
 
+// SetDisconnectReason sets the "disconnect_reason" field.
+func (iu *InfluencerUpdate) SetDisconnectReason(s string) *InfluencerUpdate {
+	iu.mutation.SetDisconnectReason(s)
+	return iu
+}
+
+// SetNillableDisconnectReason sets the "disconnect_reason" field if the given value is not nil.
+func (iu *InfluencerUpdate) SetNillableDisconnectReason(s *string) *InfluencerUpdate {
+	if s != nil {
+		iu.SetDisconnectReason(*s)
+	}
+	return iu
+}
+
+// ClearDisconnectReason clears the value of the "disconnect_reason" field.
+func (iu *InfluencerUpdate) ClearDisconnectReason() *InfluencerUpdate {
+	iu.mutation.ClearDisconnectReason()
+	return iu
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (iu *InfluencerUpdate) SetOrganizationID(s string) *InfluencerUpdate {
+	iu.mutation.SetOrganizationID(s)
+	return iu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (iu *InfluencerUpdate) SetNillableOrganizationID(s *string) *InfluencerUpdate {
+	if s != nil {
+		iu.SetOrganizationID(*s)
+	}
+	return iu
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (iu *InfluencerUpdate) ClearOrganizationID() *InfluencerUpdate {
+	iu.mutation.ClearOrganizationID()
+	return iu
+}
+
+// SetAccountVerifiedAt sets the "account_verified_at" field.
+func (iu *InfluencerUpdate) SetAccountVerifiedAt(t time.Time) *InfluencerUpdate {
+	iu.mutation.SetAccountVerifiedAt(t)
+	return iu
+}
+
+// SetNillableAccountVerifiedAt sets the "account_verified_at" field if the given value is not nil.
+func (iu *InfluencerUpdate) SetNillableAccountVerifiedAt(t *time.Time) *InfluencerUpdate {
+	if t != nil {
+		iu.SetAccountVerifiedAt(*t)
+	}
+	return iu
+}
+
+// ClearAccountVerifiedAt clears the value of the "account_verified_at" field.
+func (iu *InfluencerUpdate) ClearAccountVerifiedAt() *InfluencerUpdate {
+	iu.mutation.ClearAccountVerifiedAt()
+	return iu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (iu *InfluencerUpdate) SetUpdatedAt(t time.Time) *InfluencerUpdate {
 	iu.mutation.SetUpdatedAt(t)
@@ -103,6 +192,11 @@ func (iu *InfluencerUpdate) SetOwner(u *User) *InfluencerUpdate {
 	return iu.SetOwnerID(u.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (iu *InfluencerUpdate) SetOrganization(o *Organization) *InfluencerUpdate {
+	return iu.SetOrganizationID(o.ID)
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (iu *InfluencerUpdate) AddPostIDs(ids ...string) *InfluencerUpdate {
 	iu.mutation.AddPostIDs(ids...)
@@ -118,6 +212,40 @@ func (iu *InfluencerUpdate) AddPosts(p ...*Post) *InfluencerUpdate {
 	return iu.AddPostIDs(ids...)
 }
 
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (iu *InfluencerUpdate) AddAccessGrantIDs(ids ...string) *InfluencerUpdate {
+	iu.mutation.AddAccessGrantIDs(ids...)
+	return iu
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (iu *InfluencerUpdate) AddAccessGrants(a ...*AccessGrant) *InfluencerUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iu.AddAccessGrantIDs(ids...)
+}
+
+// SetCredentialID sets the "credential" edge to the Credential entity by ID.
+func (iu *InfluencerUpdate) SetCredentialID(id string) *InfluencerUpdate {
+	iu.mutation.SetCredentialID(id)
+	return iu
+}
+
+// SetNillableCredentialID sets the "credential" edge to the Credential entity by ID if the given value is not nil.
+func (iu *InfluencerUpdate) SetNillableCredentialID(id *string) *InfluencerUpdate {
+	if id != nil {
+		iu = iu.SetCredentialID(*id)
+	}
+	return iu
+}
+
+// SetCredential sets the "credential" edge to the Credential entity.
+func (iu *InfluencerUpdate) SetCredential(c *Credential) *InfluencerUpdate {
+	return iu.SetCredentialID(c.ID)
+}
+
 // Mutation returns the InfluencerMutation object of the builder.
 func (iu *InfluencerUpdate) Mutation() *InfluencerMutation {
 	return iu.mutation
@@ -129,6 +257,12 @@ func (iu *InfluencerUpdate) ClearOwner() *InfluencerUpdate {
 	return iu
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (iu *InfluencerUpdate) ClearOrganization() *InfluencerUpdate {
+	iu.mutation.ClearOrganization()
+	return iu
+}
+
 // ClearPosts clears all "posts" edges to the Post entity.
 func (iu *InfluencerUpdate) ClearPosts() *InfluencerUpdate {
 	iu.mutation.ClearPosts()
@@ -150,6 +284,33 @@ func (iu *InfluencerUpdate) RemovePosts(p ...*Post) *InfluencerUpdate {
 	return iu.RemovePostIDs(ids...)
 }
 
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (iu *InfluencerUpdate) ClearAccessGrants() *InfluencerUpdate {
+	iu.mutation.ClearAccessGrants()
+	return iu
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (iu *InfluencerUpdate) RemoveAccessGrantIDs(ids ...string) *InfluencerUpdate {
+	iu.mutation.RemoveAccessGrantIDs(ids...)
+	return iu
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (iu *InfluencerUpdate) RemoveAccessGrants(a ...*AccessGrant) *InfluencerUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iu.RemoveAccessGrantIDs(ids...)
+}
+
+// ClearCredential clears the "credential" edge to the Credential entity.
+func (iu *InfluencerUpdate) ClearCredential() *InfluencerUpdate {
+	iu.mutation.ClearCredential()
+	return iu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (iu *InfluencerUpdate) Save(ctx context.Context) (int, error) {
 	iu.defaults()
@@ -206,6 +367,12 @@ func (iu *InfluencerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := iu.mutation.DeletedAt(); ok {
+		_spec.SetField(influencer.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iu.mutation.DeletedAtCleared() {
+		_spec.ClearField(influencer.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := iu.mutation.Name(); ok {
 		_spec.SetField(influencer.FieldName, field.TypeString, value)
 	}
@@ -215,9 +382,24 @@ func (iu *InfluencerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.AccountID(); ok {
 		_spec.SetField(influencer.FieldAccountID, field.TypeString, value)
 	}
+	if iu.mutation.AccountIDCleared() {
+		_spec.ClearField(influencer.FieldAccountID, field.TypeString)
+	}
 	if value, ok := iu.mutation.Status(); ok {
 		_spec.SetField(influencer.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := iu.mutation.DisconnectReason(); ok {
+		_spec.SetField(influencer.FieldDisconnectReason, field.TypeString, value)
+	}
+	if iu.mutation.DisconnectReasonCleared() {
+		_spec.ClearField(influencer.FieldDisconnectReason, field.TypeString)
+	}
+	if value, ok := iu.mutation.AccountVerifiedAt(); ok {
+		_spec.SetField(influencer.FieldAccountVerifiedAt, field.TypeTime, value)
+	}
+	if iu.mutation.AccountVerifiedAtCleared() {
+		_spec.ClearField(influencer.FieldAccountVerifiedAt, field.TypeTime)
+	}
 	if value, ok := iu.mutation.UpdatedAt(); ok {
 		_spec.SetField(influencer.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -250,6 +432,35 @@ func (iu *InfluencerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   influencer.OrganizationTable,
+			Columns: []string{influencer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   influencer.OrganizationTable,
+			Columns: []string{influencer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if iu.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -295,6 +506,80 @@ func (iu *InfluencerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   influencer.AccessGrantsTable,
+			Columns: []string{influencer.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !iu.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   influencer.AccessGrantsTable,
+			Columns: []string{influencer.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   influencer.AccessGrantsTable,
+			Columns: []string{influencer.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.CredentialCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   influencer.CredentialTable,
+			Columns: []string{influencer.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   influencer.CredentialTable,
+			Columns: []string{influencer.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{influencer.Label}
@@ -315,6 +600,26 @@ type InfluencerUpdateOne struct {
 	mutation *InfluencerMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (iuo *InfluencerUpdateOne) SetDeletedAt(t time.Time) *InfluencerUpdateOne {
+	iuo.mutation.SetDeletedAt(t)
+	return iuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iuo *InfluencerUpdateOne) SetNillableDeletedAt(t *time.Time) *InfluencerUpdateOne {
+	if t != nil {
+		iuo.SetDeletedAt(*t)
+	}
+	return iuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iuo *InfluencerUpdateOne) ClearDeletedAt() *InfluencerUpdateOne {
+	iuo.mutation.ClearDeletedAt()
+	return iuo
+}
+
 // SetName sets the "name" field.
 func (iuo *InfluencerUpdateOne) SetName(s string) *InfluencerUpdateOne {
 	iuo.mutation.SetName(s)
@@ -357,6 +662,12 @@ func (iuo *InfluencerUpdateOne) SetNillableAccountID(s *string) *InfluencerUpdat
 	return iuo
 }
 
+// ClearAccountID clears the value of the "account_id" field.
+func (iuo *InfluencerUpdateOne) ClearAccountID() *InfluencerUpdateOne {
+	iuo.mutation.ClearAccountID()
+	return iuo
+}
+
 // SetStatus sets the "status" field.
 func (iuo *InfluencerUpdateOne) SetStatus(s string) *InfluencerUpdateOne {
 	iuo.mutation.SetStatus(s)
@@ -371,6 +682,66 @@ func (iuo *InfluencerUpdateOne) SetNillableStatus(s *string) *InfluencerUpdateOn
 	return iuo
 }
 
+// SetDisconnectReason sets the "disconnect_reason" field.
+func (iuo *InfluencerUpdateOne) SetDisconnectReason(s string) *InfluencerUpdateOne {
+	iuo.mutation.SetDisconnectReason(s)
+	return iuo
+}
+
+// SetNillableDisconnectReason sets the "disconnect_reason" field if the given value is not nil.
+func (iuo *InfluencerUpdateOne) SetNillableDisconnectReason(s *string) *InfluencerUpdateOne {
+	if s != nil {
+		iuo.SetDisconnectReason(*s)
+	}
+	return iuo
+}
+
+// ClearDisconnectReason clears the value of the "disconnect_reason" field.
+func (iuo *InfluencerUpdateOne) ClearDisconnectReason() *InfluencerUpdateOne {
+	iuo.mutation.ClearDisconnectReason()
+	return iuo
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (iuo *InfluencerUpdateOne) SetOrganizationID(s string) *InfluencerUpdateOne {
+	iuo.mutation.SetOrganizationID(s)
+	return iuo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (iuo *InfluencerUpdateOne) SetNillableOrganizationID(s *string) *InfluencerUpdateOne {
+	if s != nil {
+		iuo.SetOrganizationID(*s)
+	}
+	return iuo
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (iuo *InfluencerUpdateOne) ClearOrganizationID() *InfluencerUpdateOne {
+	iuo.mutation.ClearOrganizationID()
+	return iuo
+}
+
+// SetAccountVerifiedAt sets the "account_verified_at" field.
+func (iuo *InfluencerUpdateOne) SetAccountVerifiedAt(t time.Time) *InfluencerUpdateOne {
+	iuo.mutation.SetAccountVerifiedAt(t)
+	return iuo
+}
+
+// SetNillableAccountVerifiedAt sets the "account_verified_at" field if the given value is not nil.
+func (iuo *InfluencerUpdateOne) SetNillableAccountVerifiedAt(t *time.Time) *InfluencerUpdateOne {
+	if t != nil {
+		iuo.SetAccountVerifiedAt(*t)
+	}
+	return iuo
+}
+
+// ClearAccountVerifiedAt clears the value of the "account_verified_at" field.
+func (iuo *InfluencerUpdateOne) ClearAccountVerifiedAt() *InfluencerUpdateOne {
+	iuo.mutation.ClearAccountVerifiedAt()
+	return iuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (iuo *InfluencerUpdateOne) SetUpdatedAt(t time.Time) *InfluencerUpdateOne {
 	iuo.mutation.SetUpdatedAt(t)
@@ -388,6 +759,11 @@ func (iuo *InfluencerUpdateOne) SetOwner(u *User) *InfluencerUpdateOne {
 	return iuo.SetOwnerID(u.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (iuo *InfluencerUpdateOne) SetOrganization(o *Organization) *InfluencerUpdateOne {
+	return iuo.SetOrganizationID(o.ID)
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (iuo *InfluencerUpdateOne) AddPostIDs(ids ...string) *InfluencerUpdateOne {
 	iuo.mutation.AddPostIDs(ids...)
@@ -403,6 +779,40 @@ func (iuo *InfluencerUpdateOne) AddPosts(p ...*Post) *InfluencerUpdateOne {
 	return iuo.AddPostIDs(ids...)
 }
 
+// AddAccessGrantIDs adds the "access_grants" edge to the AccessGrant entity by IDs.
+func (iuo *InfluencerUpdateOne) AddAccessGrantIDs(ids ...string) *InfluencerUpdateOne {
+	iuo.mutation.AddAccessGrantIDs(ids...)
+	return iuo
+}
+
+// AddAccessGrants adds the "access_grants" edges to the AccessGrant entity.
+func (iuo *InfluencerUpdateOne) AddAccessGrants(a ...*AccessGrant) *InfluencerUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iuo.AddAccessGrantIDs(ids...)
+}
+
+// SetCredentialID sets the "credential" edge to the Credential entity by ID.
+func (iuo *InfluencerUpdateOne) SetCredentialID(id string) *InfluencerUpdateOne {
+	iuo.mutation.SetCredentialID(id)
+	return iuo
+}
+
+// SetNillableCredentialID sets the "credential" edge to the Credential entity by ID if the given value is not nil.
+func (iuo *InfluencerUpdateOne) SetNillableCredentialID(id *string) *InfluencerUpdateOne {
+	if id != nil {
+		iuo = iuo.SetCredentialID(*id)
+	}
+	return iuo
+}
+
+// SetCredential sets the "credential" edge to the Credential entity.
+func (iuo *InfluencerUpdateOne) SetCredential(c *Credential) *InfluencerUpdateOne {
+	return iuo.SetCredentialID(c.ID)
+}
+
 // Mutation returns the InfluencerMutation object of the builder.
 func (iuo *InfluencerUpdateOne) Mutation() *InfluencerMutation {
 	return iuo.mutation
@@ -414,6 +824,12 @@ func (iuo *InfluencerUpdateOne) ClearOwner() *InfluencerUpdateOne {
 	return iuo
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (iuo *InfluencerUpdateOne) ClearOrganization() *InfluencerUpdateOne {
+	iuo.mutation.ClearOrganization()
+	return iuo
+}
+
 // ClearPosts clears all "posts" edges to the Post entity.
 func (iuo *InfluencerUpdateOne) ClearPosts() *InfluencerUpdateOne {
 	iuo.mutation.ClearPosts()
@@ -435,6 +851,33 @@ func (iuo *InfluencerUpdateOne) RemovePosts(p ...*Post) *InfluencerUpdateOne {
 	return iuo.RemovePostIDs(ids...)
 }
 
+// ClearAccessGrants clears all "access_grants" edges to the AccessGrant entity.
+func (iuo *InfluencerUpdateOne) ClearAccessGrants() *InfluencerUpdateOne {
+	iuo.mutation.ClearAccessGrants()
+	return iuo
+}
+
+// RemoveAccessGrantIDs removes the "access_grants" edge to AccessGrant entities by IDs.
+func (iuo *InfluencerUpdateOne) RemoveAccessGrantIDs(ids ...string) *InfluencerUpdateOne {
+	iuo.mutation.RemoveAccessGrantIDs(ids...)
+	return iuo
+}
+
+// RemoveAccessGrants removes "access_grants" edges to AccessGrant entities.
+func (iuo *InfluencerUpdateOne) RemoveAccessGrants(a ...*AccessGrant) *InfluencerUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return iuo.RemoveAccessGrantIDs(ids...)
+}
+
+// ClearCredential clears the "credential" edge to the Credential entity.
+func (iuo *InfluencerUpdateOne) ClearCredential() *InfluencerUpdateOne {
+	iuo.mutation.ClearCredential()
+	return iuo
+}
+
 // Where appends a list predicates to the InfluencerUpdate builder.
 func (iuo *InfluencerUpdateOne) Where(ps ...predicate.Influencer) *InfluencerUpdateOne {
 	iuo.mutation.Where(ps...)
@@ -521,6 +964,12 @@ func (iuo *InfluencerUpdateOne) sqlSave(ctx context.Context) (_node *Influencer,
 			}
 		}
 	}
+	if value, ok := iuo.mutation.DeletedAt(); ok {
+		_spec.SetField(influencer.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(influencer.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := iuo.mutation.Name(); ok {
 		_spec.SetField(influencer.FieldName, field.TypeString, value)
 	}
@@ -530,9 +979,24 @@ func (iuo *InfluencerUpdateOne) sqlSave(ctx context.Context) (_node *Influencer,
 	if value, ok := iuo.mutation.AccountID(); ok {
 		_spec.SetField(influencer.FieldAccountID, field.TypeString, value)
 	}
+	if iuo.mutation.AccountIDCleared() {
+		_spec.ClearField(influencer.FieldAccountID, field.TypeString)
+	}
 	if value, ok := iuo.mutation.Status(); ok {
 		_spec.SetField(influencer.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := iuo.mutation.DisconnectReason(); ok {
+		_spec.SetField(influencer.FieldDisconnectReason, field.TypeString, value)
+	}
+	if iuo.mutation.DisconnectReasonCleared() {
+		_spec.ClearField(influencer.FieldDisconnectReason, field.TypeString)
+	}
+	if value, ok := iuo.mutation.AccountVerifiedAt(); ok {
+		_spec.SetField(influencer.FieldAccountVerifiedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.AccountVerifiedAtCleared() {
+		_spec.ClearField(influencer.FieldAccountVerifiedAt, field.TypeTime)
+	}
 	if value, ok := iuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(influencer.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -565,6 +1029,35 @@ func (iuo *InfluencerUpdateOne) sqlSave(ctx context.Context) (_node *Influencer,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   influencer.OrganizationTable,
+			Columns: []string{influencer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   influencer.OrganizationTable,
+			Columns: []string{influencer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if iuo.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -610,6 +1103,80 @@ func (iuo *InfluencerUpdateOne) sqlSave(ctx context.Context) (_node *Influencer,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iuo.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   influencer.AccessGrantsTable,
+			Columns: []string{influencer.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedAccessGrantsIDs(); len(nodes) > 0 && !iuo.mutation.AccessGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   influencer.AccessGrantsTable,
+			Columns: []string{influencer.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.AccessGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   influencer.AccessGrantsTable,
+			Columns: []string{influencer.AccessGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accessgrant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.CredentialCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   influencer.CredentialTable,
+			Columns: []string{influencer.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   influencer.CredentialTable,
+			Columns: []string{influencer.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Influencer{config: iuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
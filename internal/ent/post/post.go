@@ -14,6 +14,8 @@ const (
 	Label = "post"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldInfluencerID holds the string denoting the influencer_id field in the database.
 	FieldInfluencerID = "influencer_id"
 	// FieldContent holds the string denoting the content field in the database.
@@ -22,6 +24,8 @@ const (
 	FieldScheduledTime = "scheduled_time"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldTraceContext holds the string denoting the trace_context field in the database.
+	FieldTraceContext = "trace_context"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -42,10 +46,12 @@ const (
 // Columns holds all SQL columns for post fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
 	FieldInfluencerID,
 	FieldContent,
 	FieldScheduledTime,
 	FieldStatus,
+	FieldTraceContext,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -79,6 +85,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByInfluencerID orders the results by the influencer_id field.
 func ByInfluencerID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInfluencerID, opts...).ToFunc()
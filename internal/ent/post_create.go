@@ -21,6 +21,20 @@ type PostCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *PostCreate) SetDeletedAt(t time.Time) *PostCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *PostCreate) SetNillableDeletedAt(t *time.Time) *PostCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
 // SetInfluencerID sets the "influencer_id" field.
 func (pc *PostCreate) SetInfluencerID(s string) *PostCreate {
 	pc.mutation.SetInfluencerID(s)
@@ -53,6 +67,12 @@ func (pc *PostCreate) SetNillableStatus(s *string) *PostCreate {
 	return pc
 }
 
+// SetTraceContext sets the "trace_context" field.
+func (pc *PostCreate) SetTraceContext(m map[string]string) *PostCreate {
+	pc.mutation.SetTraceContext(m)
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *PostCreate) SetCreatedAt(t time.Time) *PostCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -199,6 +219,10 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := pc.mutation.Content(); ok {
 		_spec.SetField(post.FieldContent, field.TypeString, value)
 		_node.Content = value
@@ -211,6 +235,10 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := pc.mutation.TraceContext(); ok {
+		_spec.SetField(post.FieldTraceContext, field.TypeJSON, value)
+		_node.TraceContext = value
+	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -298,12 +298,12 @@ func (pq *PostQuery) WithInfluencer(opts ...func(*InfluencerQuery)) *PostQuery {
 // Example:
 //
 //	var v []struct {
-//		InfluencerID string `json:"influencer_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Post.Query().
-//		GroupBy(post.FieldInfluencerID).
+//		GroupBy(post.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pq *PostQuery) GroupBy(field string, fields ...string) *PostGroupBy {
@@ -321,11 +321,11 @@ func (pq *PostQuery) GroupBy(field string, fields ...string) *PostGroupBy {
 // Example:
 //
 //	var v []struct {
-//		InfluencerID string `json:"influencer_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Post.Query().
-//		Select(post.FieldInfluencerID).
+//		Select(post.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (pq *PostQuery) Select(fields ...string) *PostSelect {
 	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
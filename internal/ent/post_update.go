@@ -29,6 +29,26 @@ func (pu *PostUpdate) Where(ps ...predicate.Post) *PostUpdate {
 	return pu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *PostUpdate) SetDeletedAt(t time.Time) *PostUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableDeletedAt(t *time.Time) *PostUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *PostUpdate) ClearDeletedAt() *PostUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
 // SetInfluencerID sets the "influencer_id" field.
 func (pu *PostUpdate) SetInfluencerID(s string) *PostUpdate {
 	pu.mutation.SetInfluencerID(s)
@@ -85,6 +105,18 @@ func (pu *PostUpdate) SetNillableStatus(s *string) *PostUpdate {
 	return pu
 }
 
+// SetTraceContext sets the "trace_context" field.
+func (pu *PostUpdate) SetTraceContext(m map[string]string) *PostUpdate {
+	pu.mutation.SetTraceContext(m)
+	return pu
+}
+
+// ClearTraceContext clears the value of the "trace_context" field.
+func (pu *PostUpdate) ClearTraceContext() *PostUpdate {
+	pu.mutation.ClearTraceContext()
+	return pu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (pu *PostUpdate) SetUpdatedAt(t time.Time) *PostUpdate {
 	pu.mutation.SetUpdatedAt(t)
@@ -163,6 +195,12 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.ClearField(post.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := pu.mutation.Content(); ok {
 		_spec.SetField(post.FieldContent, field.TypeString, value)
 	}
@@ -172,6 +210,12 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Status(); ok {
 		_spec.SetField(post.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.TraceContext(); ok {
+		_spec.SetField(post.FieldTraceContext, field.TypeJSON, value)
+	}
+	if pu.mutation.TraceContextCleared() {
+		_spec.ClearField(post.FieldTraceContext, field.TypeJSON)
+	}
 	if value, ok := pu.mutation.UpdatedAt(); ok {
 		_spec.SetField(post.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -224,6 +268,26 @@ type PostUpdateOne struct {
 	mutation *PostMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *PostUpdateOne) SetDeletedAt(t time.Time) *PostUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableDeletedAt(t *time.Time) *PostUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *PostUpdateOne) ClearDeletedAt() *PostUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
 // SetInfluencerID sets the "influencer_id" field.
 func (puo *PostUpdateOne) SetInfluencerID(s string) *PostUpdateOne {
 	puo.mutation.SetInfluencerID(s)
@@ -280,6 +344,18 @@ func (puo *PostUpdateOne) SetNillableStatus(s *string) *PostUpdateOne {
 	return puo
 }
 
+// SetTraceContext sets the "trace_context" field.
+func (puo *PostUpdateOne) SetTraceContext(m map[string]string) *PostUpdateOne {
+	puo.mutation.SetTraceContext(m)
+	return puo
+}
+
+// ClearTraceContext clears the value of the "trace_context" field.
+func (puo *PostUpdateOne) ClearTraceContext() *PostUpdateOne {
+	puo.mutation.ClearTraceContext()
+	return puo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (puo *PostUpdateOne) SetUpdatedAt(t time.Time) *PostUpdateOne {
 	puo.mutation.SetUpdatedAt(t)
@@ -388,6 +464,12 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 			}
 		}
 	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.ClearField(post.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := puo.mutation.Content(); ok {
 		_spec.SetField(post.FieldContent, field.TypeString, value)
 	}
@@ -397,6 +479,12 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	if value, ok := puo.mutation.Status(); ok {
 		_spec.SetField(post.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.TraceContext(); ok {
+		_spec.SetField(post.FieldTraceContext, field.TypeJSON, value)
+	}
+	if puo.mutation.TraceContextCleared() {
+		_spec.ClearField(post.FieldTraceContext, field.TypeJSON)
+	}
 	if value, ok := puo.mutation.UpdatedAt(); ok {
 		_spec.SetField(post.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -5,9 +5,18 @@ package ent
 import (
 	"time"
 
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/auditevent"
+	"github.com/WuPinYi/SocialForge/internal/ent/connectsession"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+	"github.com/WuPinYi/SocialForge/internal/ent/idempotencykey"
 	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/membership"
+	"github.com/WuPinYi/SocialForge/internal/ent/notification"
+	"github.com/WuPinYi/SocialForge/internal/ent/organization"
 	"github.com/WuPinYi/SocialForge/internal/ent/post"
 	"github.com/WuPinYi/SocialForge/internal/ent/schema"
+	"github.com/WuPinYi/SocialForge/internal/ent/serviceaccount"
 	"github.com/WuPinYi/SocialForge/internal/ent/user"
 )
 
@@ -15,6 +24,52 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accessgrantFields := schema.AccessGrant{}.Fields()
+	_ = accessgrantFields
+	// accessgrantDescLevel is the schema descriptor for level field.
+	accessgrantDescLevel := accessgrantFields[3].Descriptor()
+	// accessgrant.DefaultLevel holds the default value on creation for the level field.
+	accessgrant.DefaultLevel = accessgrantDescLevel.Default.(string)
+	// accessgrantDescCreatedAt is the schema descriptor for created_at field.
+	accessgrantDescCreatedAt := accessgrantFields[6].Descriptor()
+	// accessgrant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accessgrant.DefaultCreatedAt = accessgrantDescCreatedAt.Default.(func() time.Time)
+	// accessgrantDescUpdatedAt is the schema descriptor for updated_at field.
+	accessgrantDescUpdatedAt := accessgrantFields[7].Descriptor()
+	// accessgrant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accessgrant.DefaultUpdatedAt = accessgrantDescUpdatedAt.Default.(func() time.Time)
+	// accessgrant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accessgrant.UpdateDefaultUpdatedAt = accessgrantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[11].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	connectsessionFields := schema.ConnectSession{}.Fields()
+	_ = connectsessionFields
+	// connectsessionDescCreatedAt is the schema descriptor for created_at field.
+	connectsessionDescCreatedAt := connectsessionFields[10].Descriptor()
+	// connectsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	connectsession.DefaultCreatedAt = connectsessionDescCreatedAt.Default.(func() time.Time)
+	credentialFields := schema.Credential{}.Fields()
+	_ = credentialFields
+	// credentialDescCreatedAt is the schema descriptor for created_at field.
+	credentialDescCreatedAt := credentialFields[6].Descriptor()
+	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
+	// credentialDescUpdatedAt is the schema descriptor for updated_at field.
+	credentialDescUpdatedAt := credentialFields[7].Descriptor()
+	// credential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	credential.DefaultUpdatedAt = credentialDescUpdatedAt.Default.(func() time.Time)
+	// credential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	credential.UpdateDefaultUpdatedAt = credentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[7].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
 	influencerFields := schema.Influencer{}.Fields()
 	_ = influencerFields
 	// influencerDescStatus is the schema descriptor for status field.
@@ -22,15 +77,53 @@ func init() {
 	// influencer.DefaultStatus holds the default value on creation for the status field.
 	influencer.DefaultStatus = influencerDescStatus.Default.(string)
 	// influencerDescCreatedAt is the schema descriptor for created_at field.
-	influencerDescCreatedAt := influencerFields[5].Descriptor()
+	influencerDescCreatedAt := influencerFields[8].Descriptor()
 	// influencer.DefaultCreatedAt holds the default value on creation for the created_at field.
 	influencer.DefaultCreatedAt = influencerDescCreatedAt.Default.(func() time.Time)
 	// influencerDescUpdatedAt is the schema descriptor for updated_at field.
-	influencerDescUpdatedAt := influencerFields[6].Descriptor()
+	influencerDescUpdatedAt := influencerFields[9].Descriptor()
 	// influencer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	influencer.DefaultUpdatedAt = influencerDescUpdatedAt.Default.(func() time.Time)
 	// influencer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	influencer.UpdateDefaultUpdatedAt = influencerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	membershipFields := schema.Membership{}.Fields()
+	_ = membershipFields
+	// membershipDescRole is the schema descriptor for role field.
+	membershipDescRole := membershipFields[3].Descriptor()
+	// membership.DefaultRole holds the default value on creation for the role field.
+	membership.DefaultRole = membershipDescRole.Default.(string)
+	// membershipDescCreatedAt is the schema descriptor for created_at field.
+	membershipDescCreatedAt := membershipFields[4].Descriptor()
+	// membership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	membership.DefaultCreatedAt = membershipDescCreatedAt.Default.(func() time.Time)
+	// membershipDescUpdatedAt is the schema descriptor for updated_at field.
+	membershipDescUpdatedAt := membershipFields[5].Descriptor()
+	// membership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	membership.DefaultUpdatedAt = membershipDescUpdatedAt.Default.(func() time.Time)
+	// membership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	membership.UpdateDefaultUpdatedAt = membershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[6].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescPersonal is the schema descriptor for personal field.
+	organizationDescPersonal := organizationFields[2].Descriptor()
+	// organization.DefaultPersonal holds the default value on creation for the personal field.
+	organization.DefaultPersonal = organizationDescPersonal.Default.(bool)
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationFields[4].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationDescUpdatedAt := organizationFields[5].Descriptor()
+	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
+	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	postFields := schema.Post{}.Fields()
 	_ = postFields
 	// postDescStatus is the schema descriptor for status field.
@@ -38,15 +131,27 @@ func init() {
 	// post.DefaultStatus holds the default value on creation for the status field.
 	post.DefaultStatus = postDescStatus.Default.(string)
 	// postDescCreatedAt is the schema descriptor for created_at field.
-	postDescCreatedAt := postFields[5].Descriptor()
+	postDescCreatedAt := postFields[6].Descriptor()
 	// post.DefaultCreatedAt holds the default value on creation for the created_at field.
 	post.DefaultCreatedAt = postDescCreatedAt.Default.(func() time.Time)
 	// postDescUpdatedAt is the schema descriptor for updated_at field.
-	postDescUpdatedAt := postFields[6].Descriptor()
+	postDescUpdatedAt := postFields[7].Descriptor()
 	// post.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	post.DefaultUpdatedAt = postDescUpdatedAt.Default.(func() time.Time)
 	// post.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	post.UpdateDefaultUpdatedAt = postDescUpdatedAt.UpdateDefault.(func() time.Time)
+	serviceaccountFields := schema.ServiceAccount{}.Fields()
+	_ = serviceaccountFields
+	// serviceaccountDescCreatedAt is the schema descriptor for created_at field.
+	serviceaccountDescCreatedAt := serviceaccountFields[9].Descriptor()
+	// serviceaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	serviceaccount.DefaultCreatedAt = serviceaccountDescCreatedAt.Default.(func() time.Time)
+	// serviceaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	serviceaccountDescUpdatedAt := serviceaccountFields[10].Descriptor()
+	// serviceaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	serviceaccount.DefaultUpdatedAt = serviceaccountDescUpdatedAt.Default.(func() time.Time)
+	// serviceaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	serviceaccount.UpdateDefaultUpdatedAt = serviceaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescRole is the schema descriptor for role field.
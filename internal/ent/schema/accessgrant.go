@@ -0,0 +1,67 @@
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate .
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccessGrant holds the schema definition for the AccessGrant entity.
+// A grant gives a single user access to a single influencer, independent of
+// organization membership, e.g. for an outside agency or freelancer.
+type AccessGrant struct {
+	ent.Schema
+}
+
+// Fields of the AccessGrant.
+func (AccessGrant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("influencer_id"),
+		field.String("user_id"),
+		field.String("level").
+			Default("viewer"),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		// granted_by is the ID of the user who created or last changed the grant
+		field.String("granted_by"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the AccessGrant.
+func (AccessGrant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("influencer", Influencer.Type).
+			Ref("access_grants").
+			Field("influencer_id").
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Ref("access_grants").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the AccessGrant.
+func (AccessGrant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("influencer_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
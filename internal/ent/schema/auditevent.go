@@ -0,0 +1,68 @@
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate .
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+// Audit events are append-only and deliberately have no edges, so the
+// history of a user, influencer or post survives its deletion.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		// actor_kind is "user", "service_account" or "system" for changes
+		// made outside of a request, e.g. by the post worker
+		field.String("actor_kind").
+			Immutable(),
+		field.String("actor_id").
+			Optional().
+			Immutable(),
+		field.String("actor_subject").
+			Optional().
+			Immutable(),
+		field.String("service_account_id").
+			Optional().
+			Immutable(),
+		field.String("operation").
+			Immutable(),
+		field.String("entity_type").
+			Immutable(),
+		field.String("entity_id").
+			Immutable(),
+		// changes maps each changed field to its {"before", "after"} values
+		field.JSON("changes", map[string]any{}).
+			Optional().
+			Immutable(),
+		field.String("request_id").
+			Optional().
+			Immutable(),
+		field.String("client_ip").
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("actor_id"),
+	}
+}
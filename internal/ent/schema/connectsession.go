@@ -0,0 +1,66 @@
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate .
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConnectSession holds the schema definition for the ConnectSession entity.
+// A session is created when an OAuth connect flow starts and is consumed by
+// the callback, carrying the PKCE verifier and who started the flow across
+// the redirect.
+type ConnectSession struct {
+	ent.Schema
+}
+
+// Fields of the ConnectSession.
+func (ConnectSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		// state is the opaque value round-tripped through the provider
+		field.String("state").
+			Unique().
+			Immutable(),
+		field.String("code_verifier").
+			Sensitive().
+			Immutable(),
+		field.String("platform").
+			Immutable(),
+		field.String("user_id").
+			Immutable(),
+		field.String("organization_id").
+			Immutable(),
+		// influencer_id is set when an existing influencer is reconnected
+		field.String("influencer_id").
+			Optional().
+			Immutable(),
+		// name is used for the influencer created when the flow completes
+		field.String("name").
+			Optional().
+			Immutable(),
+		// redirect_url is set when a native app receives the redirect
+		// itself and completes the flow with the code
+		field.String("redirect_url").
+			Optional().
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ConnectSession.
+func (ConnectSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,65 @@
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate .
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Credential holds the schema definition for the Credential entity.
+// It stores an influencer's platform secrets with envelope encryption: the
+// secret is sealed with a random data key, and the data key is sealed with
+// the key-encryption key identified by key_version. Nothing in this table
+// is readable without the vault keyring.
+type Credential struct {
+	ent.Schema
+}
+
+// Fields of the Credential.
+func (Credential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("influencer_id"),
+		field.Bytes("ciphertext").
+			Sensitive(),
+		field.Bytes("wrapped_key").
+			Sensitive(),
+		field.Int("key_version"),
+		// expires_at is the expiry of the stored access token, kept in the
+		// clear so expiring credentials can be found without decrypting
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Credential.
+func (Credential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("influencer", Influencer.Type).
+			Ref("credential").
+			Field("influencer_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Credential.
+func (Credential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key_version"),
+	}
+}
@@ -0,0 +1,57 @@
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate .
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// It records a create call made with an idempotency key, so that retries
+// with the same key get the original response instead of creating another
+// resource.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		// caller is the service account or user that made the call; keys
+		// are only unique per caller
+		field.String("caller").
+			Immutable(),
+		field.String("method").
+			Immutable(),
+		field.String("key").
+			Immutable(),
+		// request_hash is the SHA-256 of the request without its key
+		field.Bytes("request_hash").
+			Immutable(),
+		// response is the marshaled google.protobuf.Any of the response.
+		// It is empty while the first call is still running.
+		field.Bytes("response").
+			Optional(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("caller", "method", "key").
+			Unique(),
+		index.Fields("expires_at"),
+	}
+}
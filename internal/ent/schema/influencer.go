@@ -16,6 +16,13 @@ type Influencer struct {
 	ent.Schema
 }
 
+// Mixin of the Influencer.
+func (Influencer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Influencer.
 func (Influencer) Fields() []ent.Field {
 	return []ent.Field{
@@ -24,9 +31,28 @@ func (Influencer) Fields() []ent.Field {
 			Immutable(),
 		field.String("name"),
 		field.String("platform"),
-		field.String("account_id"),
+		// account_id is only set by the connect flow, once the platform has
+		// proven it; influencers created before then may have an unverified
+		// one, which gives way to a verified connect
+		field.String("account_id").
+			Optional(),
+		// status is "active", or "disconnected" once its platform token
+		// could not be refreshed; it becomes active again on reconnect
 		field.String("status").
 			Default("active"),
+		// disconnect_reason is why the token refresh failed
+		field.String("disconnect_reason").
+			Optional(),
+		// organization_id is optional only for influencers created before
+		// organizations existed; they are adopted by the owner's personal
+		// organization when it is created.
+		field.String("organization_id").
+			Optional(),
+		// account_verified_at is set when the account ID was proven through
+		// the OAuth connect flow rather than entered by hand.
+		field.Time("account_verified_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -43,7 +69,14 @@ func (Influencer) Edges() []ent.Edge {
 			Ref("influencers").
 			Unique().
 			Required(),
+		edge.From("organization", Organization.Type).
+			Ref("influencers").
+			Field("organization_id").
+			Unique(),
 		edge.To("posts", Post.Type),
+		edge.To("access_grants", AccessGrant.Type),
+		edge.To("credential", Credential.Type).
+			Unique(),
 	}
 }
 
@@ -51,5 +84,6 @@ func (Influencer) Edges() []ent.Edge {
 func (Influencer) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("platform", "account_id").Unique(),
+		index.Fields("organization_id"),
 	}
 }
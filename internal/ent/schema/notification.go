@@ -0,0 +1,63 @@
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate .
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification holds the schema definition for the Notification entity.
+// Notifications tell a user about something that needs their attention,
+// such as an influencer account that has to be reconnected.
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			Immutable(),
+		// kind identifies the event, e.g. "influencer_disconnected"
+		field.String("kind").
+			Immutable(),
+		field.String("influencer_id").
+			Optional().
+			Immutable(),
+		field.Text("message").
+			Immutable(),
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notifications").
+			Field("user_id").
+			Immutable().
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "read_at"),
+	}
+}
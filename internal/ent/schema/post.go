@@ -16,6 +16,13 @@ type Post struct {
 	ent.Schema
 }
 
+// Mixin of the Post.
+func (Post) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Post.
 func (Post) Fields() []ent.Field {
 	return []ent.Field{
@@ -27,6 +34,11 @@ func (Post) Fields() []ent.Field {
 		field.Time("scheduled_time"),
 		field.String("status").
 			Default("scheduled"),
+		// trace_context is the W3C trace context of the request that last
+		// set scheduled_time, so that publishing can be linked back to it
+		field.JSON("trace_context", map[string]string{}).
+			Optional().
+			Sensitive(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -8,7 +8,6 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
-	"entgo.io/ent/schema/index"
 )
 
 // User holds the schema definition for the User entity.
@@ -22,8 +21,11 @@ func (User) Fields() []ent.Field {
 		field.String("id").
 			Unique().
 			Immutable(),
+		// email is optional because access tokens don't always carry it;
+		// it is filled in from the claims on a later request when present.
 		field.String("email").
-			Unique(),
+			Unique().
+			Optional(),
 		field.String("name"),
 		field.String("auth0_id").
 			Unique(),
@@ -42,13 +44,9 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("influencers", Influencer.Type),
-	}
-}
-
-// Indexes of the User.
-func (User) Indexes() []ent.Index {
-	return []ent.Index{
-		index.Fields("email").Unique(),
-		index.Fields("auth0_id").Unique(),
+		edge.To("service_accounts", ServiceAccount.Type),
+		edge.To("memberships", Membership.Type),
+		edge.To("access_grants", AccessGrant.Type),
+		edge.To("notifications", Notification.Type),
 	}
 }
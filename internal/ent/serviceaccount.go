@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/WuPinYi/SocialForge/internal/ent/serviceaccount"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+)
+
+// ServiceAccount is the model entity for the ServiceAccount schema.
+type ServiceAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// KeyPrefix holds the value of the "key_prefix" field.
+	KeyPrefix string `json:"key_prefix,omitempty"`
+	// KeyHash holds the value of the "key_hash" field.
+	KeyHash string `json:"-"`
+	// ClientCertIdentity holds the value of the "client_cert_identity" field.
+	ClientCertIdentity *string `json:"client_cert_identity,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ServiceAccountQuery when eager-loading is set.
+	Edges                 ServiceAccountEdges `json:"edges"`
+	user_service_accounts *string
+	selectValues          sql.SelectValues
+}
+
+// ServiceAccountEdges holds the relations/edges for other nodes in the graph.
+type ServiceAccountEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ServiceAccountEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ServiceAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case serviceaccount.FieldScopes:
+			values[i] = new([]byte)
+		case serviceaccount.FieldID, serviceaccount.FieldName, serviceaccount.FieldKeyPrefix, serviceaccount.FieldKeyHash, serviceaccount.FieldClientCertIdentity:
+			values[i] = new(sql.NullString)
+		case serviceaccount.FieldExpiresAt, serviceaccount.FieldLastUsedAt, serviceaccount.FieldRevokedAt, serviceaccount.FieldCreatedAt, serviceaccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case serviceaccount.ForeignKeys[0]: // user_service_accounts
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ServiceAccount fields.
+func (sa *ServiceAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case serviceaccount.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sa.ID = value.String
+			}
+		case serviceaccount.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sa.Name = value.String
+			}
+		case serviceaccount.FieldKeyPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_prefix", values[i])
+			} else if value.Valid {
+				sa.KeyPrefix = value.String
+			}
+		case serviceaccount.FieldKeyHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_hash", values[i])
+			} else if value.Valid {
+				sa.KeyHash = value.String
+			}
+		case serviceaccount.FieldClientCertIdentity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_cert_identity", values[i])
+			} else if value.Valid {
+				sa.ClientCertIdentity = new(string)
+				*sa.ClientCertIdentity = value.String
+			}
+		case serviceaccount.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sa.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case serviceaccount.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				sa.ExpiresAt = new(time.Time)
+				*sa.ExpiresAt = value.Time
+			}
+		case serviceaccount.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				sa.LastUsedAt = new(time.Time)
+				*sa.LastUsedAt = value.Time
+			}
+		case serviceaccount.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				sa.RevokedAt = new(time.Time)
+				*sa.RevokedAt = value.Time
+			}
+		case serviceaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		case serviceaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sa.UpdatedAt = value.Time
+			}
+		case serviceaccount.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_service_accounts", values[i])
+			} else if value.Valid {
+				sa.user_service_accounts = new(string)
+				*sa.user_service_accounts = value.String
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ServiceAccount.
+// This includes values selected through modifiers, order, etc.
+func (sa *ServiceAccount) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the ServiceAccount entity.
+func (sa *ServiceAccount) QueryOwner() *UserQuery {
+	return NewServiceAccountClient(sa.config).QueryOwner(sa)
+}
+
+// Update returns a builder for updating this ServiceAccount.
+// Note that you need to call ServiceAccount.Unwrap() before calling this method if this ServiceAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *ServiceAccount) Update() *ServiceAccountUpdateOne {
+	return NewServiceAccountClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the ServiceAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *ServiceAccount) Unwrap() *ServiceAccount {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ServiceAccount is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *ServiceAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("ServiceAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("name=")
+	builder.WriteString(sa.Name)
+	builder.WriteString(", ")
+	builder.WriteString("key_prefix=")
+	builder.WriteString(sa.KeyPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("key_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := sa.ClientCertIdentity; v != nil {
+		builder.WriteString("client_cert_identity=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", sa.Scopes))
+	builder.WriteString(", ")
+	if v := sa.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sa.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sa.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ServiceAccounts is a parsable slice of ServiceAccount.
+type ServiceAccounts []*ServiceAccount
@@ -0,0 +1,659 @@
+// Code generated by ent, DO NOT EDIT.
+
+package serviceaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldName, v))
+}
+
+// KeyPrefix applies equality check predicate on the "key_prefix" field. It's identical to KeyPrefixEQ.
+func KeyPrefix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldKeyPrefix, v))
+}
+
+// KeyHash applies equality check predicate on the "key_hash" field. It's identical to KeyHashEQ.
+func KeyHash(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldKeyHash, v))
+}
+
+// ClientCertIdentity applies equality check predicate on the "client_cert_identity" field. It's identical to ClientCertIdentityEQ.
+func ClientCertIdentity(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldClientCertIdentity, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldContainsFold(FieldName, v))
+}
+
+// KeyPrefixEQ applies the EQ predicate on the "key_prefix" field.
+func KeyPrefixEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldKeyPrefix, v))
+}
+
+// KeyPrefixNEQ applies the NEQ predicate on the "key_prefix" field.
+func KeyPrefixNEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNEQ(FieldKeyPrefix, v))
+}
+
+// KeyPrefixIn applies the In predicate on the "key_prefix" field.
+func KeyPrefixIn(vs ...string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIn(FieldKeyPrefix, vs...))
+}
+
+// KeyPrefixNotIn applies the NotIn predicate on the "key_prefix" field.
+func KeyPrefixNotIn(vs ...string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotIn(FieldKeyPrefix, vs...))
+}
+
+// KeyPrefixGT applies the GT predicate on the "key_prefix" field.
+func KeyPrefixGT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGT(FieldKeyPrefix, v))
+}
+
+// KeyPrefixGTE applies the GTE predicate on the "key_prefix" field.
+func KeyPrefixGTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGTE(FieldKeyPrefix, v))
+}
+
+// KeyPrefixLT applies the LT predicate on the "key_prefix" field.
+func KeyPrefixLT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLT(FieldKeyPrefix, v))
+}
+
+// KeyPrefixLTE applies the LTE predicate on the "key_prefix" field.
+func KeyPrefixLTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLTE(FieldKeyPrefix, v))
+}
+
+// KeyPrefixContains applies the Contains predicate on the "key_prefix" field.
+func KeyPrefixContains(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldContains(FieldKeyPrefix, v))
+}
+
+// KeyPrefixHasPrefix applies the HasPrefix predicate on the "key_prefix" field.
+func KeyPrefixHasPrefix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldHasPrefix(FieldKeyPrefix, v))
+}
+
+// KeyPrefixHasSuffix applies the HasSuffix predicate on the "key_prefix" field.
+func KeyPrefixHasSuffix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldHasSuffix(FieldKeyPrefix, v))
+}
+
+// KeyPrefixEqualFold applies the EqualFold predicate on the "key_prefix" field.
+func KeyPrefixEqualFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEqualFold(FieldKeyPrefix, v))
+}
+
+// KeyPrefixContainsFold applies the ContainsFold predicate on the "key_prefix" field.
+func KeyPrefixContainsFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldContainsFold(FieldKeyPrefix, v))
+}
+
+// KeyHashEQ applies the EQ predicate on the "key_hash" field.
+func KeyHashEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldKeyHash, v))
+}
+
+// KeyHashNEQ applies the NEQ predicate on the "key_hash" field.
+func KeyHashNEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNEQ(FieldKeyHash, v))
+}
+
+// KeyHashIn applies the In predicate on the "key_hash" field.
+func KeyHashIn(vs ...string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIn(FieldKeyHash, vs...))
+}
+
+// KeyHashNotIn applies the NotIn predicate on the "key_hash" field.
+func KeyHashNotIn(vs ...string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotIn(FieldKeyHash, vs...))
+}
+
+// KeyHashGT applies the GT predicate on the "key_hash" field.
+func KeyHashGT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGT(FieldKeyHash, v))
+}
+
+// KeyHashGTE applies the GTE predicate on the "key_hash" field.
+func KeyHashGTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGTE(FieldKeyHash, v))
+}
+
+// KeyHashLT applies the LT predicate on the "key_hash" field.
+func KeyHashLT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLT(FieldKeyHash, v))
+}
+
+// KeyHashLTE applies the LTE predicate on the "key_hash" field.
+func KeyHashLTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLTE(FieldKeyHash, v))
+}
+
+// KeyHashContains applies the Contains predicate on the "key_hash" field.
+func KeyHashContains(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldContains(FieldKeyHash, v))
+}
+
+// KeyHashHasPrefix applies the HasPrefix predicate on the "key_hash" field.
+func KeyHashHasPrefix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldHasPrefix(FieldKeyHash, v))
+}
+
+// KeyHashHasSuffix applies the HasSuffix predicate on the "key_hash" field.
+func KeyHashHasSuffix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldHasSuffix(FieldKeyHash, v))
+}
+
+// KeyHashEqualFold applies the EqualFold predicate on the "key_hash" field.
+func KeyHashEqualFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEqualFold(FieldKeyHash, v))
+}
+
+// KeyHashContainsFold applies the ContainsFold predicate on the "key_hash" field.
+func KeyHashContainsFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldContainsFold(FieldKeyHash, v))
+}
+
+// ClientCertIdentityEQ applies the EQ predicate on the "client_cert_identity" field.
+func ClientCertIdentityEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldClientCertIdentity, v))
+}
+
+// ClientCertIdentityNEQ applies the NEQ predicate on the "client_cert_identity" field.
+func ClientCertIdentityNEQ(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNEQ(FieldClientCertIdentity, v))
+}
+
+// ClientCertIdentityIn applies the In predicate on the "client_cert_identity" field.
+func ClientCertIdentityIn(vs ...string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIn(FieldClientCertIdentity, vs...))
+}
+
+// ClientCertIdentityNotIn applies the NotIn predicate on the "client_cert_identity" field.
+func ClientCertIdentityNotIn(vs ...string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotIn(FieldClientCertIdentity, vs...))
+}
+
+// ClientCertIdentityGT applies the GT predicate on the "client_cert_identity" field.
+func ClientCertIdentityGT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGT(FieldClientCertIdentity, v))
+}
+
+// ClientCertIdentityGTE applies the GTE predicate on the "client_cert_identity" field.
+func ClientCertIdentityGTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGTE(FieldClientCertIdentity, v))
+}
+
+// ClientCertIdentityLT applies the LT predicate on the "client_cert_identity" field.
+func ClientCertIdentityLT(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLT(FieldClientCertIdentity, v))
+}
+
+// ClientCertIdentityLTE applies the LTE predicate on the "client_cert_identity" field.
+func ClientCertIdentityLTE(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLTE(FieldClientCertIdentity, v))
+}
+
+// ClientCertIdentityContains applies the Contains predicate on the "client_cert_identity" field.
+func ClientCertIdentityContains(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldContains(FieldClientCertIdentity, v))
+}
+
+// ClientCertIdentityHasPrefix applies the HasPrefix predicate on the "client_cert_identity" field.
+func ClientCertIdentityHasPrefix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldHasPrefix(FieldClientCertIdentity, v))
+}
+
+// ClientCertIdentityHasSuffix applies the HasSuffix predicate on the "client_cert_identity" field.
+func ClientCertIdentityHasSuffix(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldHasSuffix(FieldClientCertIdentity, v))
+}
+
+// ClientCertIdentityIsNil applies the IsNil predicate on the "client_cert_identity" field.
+func ClientCertIdentityIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIsNull(FieldClientCertIdentity))
+}
+
+// ClientCertIdentityNotNil applies the NotNil predicate on the "client_cert_identity" field.
+func ClientCertIdentityNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotNull(FieldClientCertIdentity))
+}
+
+// ClientCertIdentityEqualFold applies the EqualFold predicate on the "client_cert_identity" field.
+func ClientCertIdentityEqualFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEqualFold(FieldClientCertIdentity, v))
+}
+
+// ClientCertIdentityContainsFold applies the ContainsFold predicate on the "client_cert_identity" field.
+func ClientCertIdentityContainsFold(v string) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldContainsFold(FieldClientCertIdentity, v))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotNull(FieldScopes))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.ServiceAccount {
+	return predicate.ServiceAccount(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ServiceAccount) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ServiceAccount) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ServiceAccount) predicate.ServiceAccount {
+	return predicate.ServiceAccount(sql.NotPredicates(p))
+}
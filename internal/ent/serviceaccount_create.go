@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/serviceaccount"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+)
+
+// ServiceAccountCreate is the builder for creating a ServiceAccount entity.
+type ServiceAccountCreate struct {
+	config
+	mutation *ServiceAccountMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sac *ServiceAccountCreate) SetName(s string) *ServiceAccountCreate {
+	sac.mutation.SetName(s)
+	return sac
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (sac *ServiceAccountCreate) SetKeyPrefix(s string) *ServiceAccountCreate {
+	sac.mutation.SetKeyPrefix(s)
+	return sac
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (sac *ServiceAccountCreate) SetKeyHash(s string) *ServiceAccountCreate {
+	sac.mutation.SetKeyHash(s)
+	return sac
+}
+
+// SetClientCertIdentity sets the "client_cert_identity" field.
+func (sac *ServiceAccountCreate) SetClientCertIdentity(s string) *ServiceAccountCreate {
+	sac.mutation.SetClientCertIdentity(s)
+	return sac
+}
+
+// SetNillableClientCertIdentity sets the "client_cert_identity" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableClientCertIdentity(s *string) *ServiceAccountCreate {
+	if s != nil {
+		sac.SetClientCertIdentity(*s)
+	}
+	return sac
+}
+
+// SetScopes sets the "scopes" field.
+func (sac *ServiceAccountCreate) SetScopes(s []string) *ServiceAccountCreate {
+	sac.mutation.SetScopes(s)
+	return sac
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sac *ServiceAccountCreate) SetExpiresAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetExpiresAt(t)
+	return sac
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableExpiresAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetExpiresAt(*t)
+	}
+	return sac
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (sac *ServiceAccountCreate) SetLastUsedAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetLastUsedAt(t)
+	return sac
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableLastUsedAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetLastUsedAt(*t)
+	}
+	return sac
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (sac *ServiceAccountCreate) SetRevokedAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetRevokedAt(t)
+	return sac
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableRevokedAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetRevokedAt(*t)
+	}
+	return sac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *ServiceAccountCreate) SetCreatedAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableCreatedAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sac *ServiceAccountCreate) SetUpdatedAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetUpdatedAt(t)
+	return sac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableUpdatedAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetUpdatedAt(*t)
+	}
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *ServiceAccountCreate) SetID(s string) *ServiceAccountCreate {
+	sac.mutation.SetID(s)
+	return sac
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (sac *ServiceAccountCreate) SetOwnerID(id string) *ServiceAccountCreate {
+	sac.mutation.SetOwnerID(id)
+	return sac
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (sac *ServiceAccountCreate) SetOwner(u *User) *ServiceAccountCreate {
+	return sac.SetOwnerID(u.ID)
+}
+
+// Mutation returns the ServiceAccountMutation object of the builder.
+func (sac *ServiceAccountCreate) Mutation() *ServiceAccountMutation {
+	return sac.mutation
+}
+
+// Save creates the ServiceAccount in the database.
+func (sac *ServiceAccountCreate) Save(ctx context.Context) (*ServiceAccount, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *ServiceAccountCreate) SaveX(ctx context.Context) *ServiceAccount {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *ServiceAccountCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *ServiceAccountCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *ServiceAccountCreate) defaults() {
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := serviceaccount.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		v := serviceaccount.DefaultUpdatedAt()
+		sac.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *ServiceAccountCreate) check() error {
+	if _, ok := sac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ServiceAccount.name"`)}
+	}
+	if _, ok := sac.mutation.KeyPrefix(); !ok {
+		return &ValidationError{Name: "key_prefix", err: errors.New(`ent: missing required field "ServiceAccount.key_prefix"`)}
+	}
+	if _, ok := sac.mutation.KeyHash(); !ok {
+		return &ValidationError{Name: "key_hash", err: errors.New(`ent: missing required field "ServiceAccount.key_hash"`)}
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ServiceAccount.created_at"`)}
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ServiceAccount.updated_at"`)}
+	}
+	if len(sac.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "ServiceAccount.owner"`)}
+	}
+	return nil
+}
+
+func (sac *ServiceAccountCreate) sqlSave(ctx context.Context) (*ServiceAccount, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ServiceAccount.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *ServiceAccountCreate) createSpec() (*ServiceAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ServiceAccount{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(serviceaccount.Table, sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeString))
+	)
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sac.mutation.Name(); ok {
+		_spec.SetField(serviceaccount.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sac.mutation.KeyPrefix(); ok {
+		_spec.SetField(serviceaccount.FieldKeyPrefix, field.TypeString, value)
+		_node.KeyPrefix = value
+	}
+	if value, ok := sac.mutation.KeyHash(); ok {
+		_spec.SetField(serviceaccount.FieldKeyHash, field.TypeString, value)
+		_node.KeyHash = value
+	}
+	if value, ok := sac.mutation.ClientCertIdentity(); ok {
+		_spec.SetField(serviceaccount.FieldClientCertIdentity, field.TypeString, value)
+		_node.ClientCertIdentity = &value
+	}
+	if value, ok := sac.mutation.Scopes(); ok {
+		_spec.SetField(serviceaccount.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := sac.mutation.ExpiresAt(); ok {
+		_spec.SetField(serviceaccount.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := sac.mutation.LastUsedAt(); ok {
+		_spec.SetField(serviceaccount.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := sac.mutation.RevokedAt(); ok {
+		_spec.SetField(serviceaccount.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sac.mutation.UpdatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sac.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OwnerTable,
+			Columns: []string{serviceaccount.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_service_accounts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ServiceAccountCreateBulk is the builder for creating many ServiceAccount entities in bulk.
+type ServiceAccountCreateBulk struct {
+	config
+	err      error
+	builders []*ServiceAccountCreate
+}
+
+// Save creates the ServiceAccount entities in the database.
+func (sacb *ServiceAccountCreateBulk) Save(ctx context.Context) ([]*ServiceAccount, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*ServiceAccount, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ServiceAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *ServiceAccountCreateBulk) SaveX(ctx context.Context) []*ServiceAccount {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *ServiceAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *ServiceAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,696 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+	"github.com/WuPinYi/SocialForge/internal/ent/serviceaccount"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+)
+
+// ServiceAccountUpdate is the builder for updating ServiceAccount entities.
+type ServiceAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ServiceAccountMutation
+}
+
+// Where appends a list predicates to the ServiceAccountUpdate builder.
+func (sau *ServiceAccountUpdate) Where(ps ...predicate.ServiceAccount) *ServiceAccountUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetName sets the "name" field.
+func (sau *ServiceAccountUpdate) SetName(s string) *ServiceAccountUpdate {
+	sau.mutation.SetName(s)
+	return sau
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableName(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetName(*s)
+	}
+	return sau
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (sau *ServiceAccountUpdate) SetKeyPrefix(s string) *ServiceAccountUpdate {
+	sau.mutation.SetKeyPrefix(s)
+	return sau
+}
+
+// SetNillableKeyPrefix sets the "key_prefix" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableKeyPrefix(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetKeyPrefix(*s)
+	}
+	return sau
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (sau *ServiceAccountUpdate) SetKeyHash(s string) *ServiceAccountUpdate {
+	sau.mutation.SetKeyHash(s)
+	return sau
+}
+
+// SetNillableKeyHash sets the "key_hash" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableKeyHash(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetKeyHash(*s)
+	}
+	return sau
+}
+
+// SetClientCertIdentity sets the "client_cert_identity" field.
+func (sau *ServiceAccountUpdate) SetClientCertIdentity(s string) *ServiceAccountUpdate {
+	sau.mutation.SetClientCertIdentity(s)
+	return sau
+}
+
+// SetNillableClientCertIdentity sets the "client_cert_identity" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableClientCertIdentity(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetClientCertIdentity(*s)
+	}
+	return sau
+}
+
+// ClearClientCertIdentity clears the value of the "client_cert_identity" field.
+func (sau *ServiceAccountUpdate) ClearClientCertIdentity() *ServiceAccountUpdate {
+	sau.mutation.ClearClientCertIdentity()
+	return sau
+}
+
+// SetScopes sets the "scopes" field.
+func (sau *ServiceAccountUpdate) SetScopes(s []string) *ServiceAccountUpdate {
+	sau.mutation.SetScopes(s)
+	return sau
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (sau *ServiceAccountUpdate) AppendScopes(s []string) *ServiceAccountUpdate {
+	sau.mutation.AppendScopes(s)
+	return sau
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (sau *ServiceAccountUpdate) ClearScopes() *ServiceAccountUpdate {
+	sau.mutation.ClearScopes()
+	return sau
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sau *ServiceAccountUpdate) SetExpiresAt(t time.Time) *ServiceAccountUpdate {
+	sau.mutation.SetExpiresAt(t)
+	return sau
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableExpiresAt(t *time.Time) *ServiceAccountUpdate {
+	if t != nil {
+		sau.SetExpiresAt(*t)
+	}
+	return sau
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (sau *ServiceAccountUpdate) ClearExpiresAt() *ServiceAccountUpdate {
+	sau.mutation.ClearExpiresAt()
+	return sau
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (sau *ServiceAccountUpdate) SetLastUsedAt(t time.Time) *ServiceAccountUpdate {
+	sau.mutation.SetLastUsedAt(t)
+	return sau
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableLastUsedAt(t *time.Time) *ServiceAccountUpdate {
+	if t != nil {
+		sau.SetLastUsedAt(*t)
+	}
+	return sau
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (sau *ServiceAccountUpdate) ClearLastUsedAt() *ServiceAccountUpdate {
+	sau.mutation.ClearLastUsedAt()
+	return sau
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (sau *ServiceAccountUpdate) SetRevokedAt(t time.Time) *ServiceAccountUpdate {
+	sau.mutation.SetRevokedAt(t)
+	return sau
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableRevokedAt(t *time.Time) *ServiceAccountUpdate {
+	if t != nil {
+		sau.SetRevokedAt(*t)
+	}
+	return sau
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (sau *ServiceAccountUpdate) ClearRevokedAt() *ServiceAccountUpdate {
+	sau.mutation.ClearRevokedAt()
+	return sau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sau *ServiceAccountUpdate) SetUpdatedAt(t time.Time) *ServiceAccountUpdate {
+	sau.mutation.SetUpdatedAt(t)
+	return sau
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (sau *ServiceAccountUpdate) SetOwnerID(id string) *ServiceAccountUpdate {
+	sau.mutation.SetOwnerID(id)
+	return sau
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (sau *ServiceAccountUpdate) SetOwner(u *User) *ServiceAccountUpdate {
+	return sau.SetOwnerID(u.ID)
+}
+
+// Mutation returns the ServiceAccountMutation object of the builder.
+func (sau *ServiceAccountUpdate) Mutation() *ServiceAccountMutation {
+	return sau.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (sau *ServiceAccountUpdate) ClearOwner() *ServiceAccountUpdate {
+	sau.mutation.ClearOwner()
+	return sau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *ServiceAccountUpdate) Save(ctx context.Context) (int, error) {
+	sau.defaults()
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *ServiceAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *ServiceAccountUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *ServiceAccountUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sau *ServiceAccountUpdate) defaults() {
+	if _, ok := sau.mutation.UpdatedAt(); !ok {
+		v := serviceaccount.UpdateDefaultUpdatedAt()
+		sau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *ServiceAccountUpdate) check() error {
+	if sau.mutation.OwnerCleared() && len(sau.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ServiceAccount.owner"`)
+	}
+	return nil
+}
+
+func (sau *ServiceAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(serviceaccount.Table, serviceaccount.Columns, sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeString))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.Name(); ok {
+		_spec.SetField(serviceaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.KeyPrefix(); ok {
+		_spec.SetField(serviceaccount.FieldKeyPrefix, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.KeyHash(); ok {
+		_spec.SetField(serviceaccount.FieldKeyHash, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.ClientCertIdentity(); ok {
+		_spec.SetField(serviceaccount.FieldClientCertIdentity, field.TypeString, value)
+	}
+	if sau.mutation.ClientCertIdentityCleared() {
+		_spec.ClearField(serviceaccount.FieldClientCertIdentity, field.TypeString)
+	}
+	if value, ok := sau.mutation.Scopes(); ok {
+		_spec.SetField(serviceaccount.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := sau.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serviceaccount.FieldScopes, value)
+		})
+	}
+	if sau.mutation.ScopesCleared() {
+		_spec.ClearField(serviceaccount.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := sau.mutation.ExpiresAt(); ok {
+		_spec.SetField(serviceaccount.FieldExpiresAt, field.TypeTime, value)
+	}
+	if sau.mutation.ExpiresAtCleared() {
+		_spec.ClearField(serviceaccount.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := sau.mutation.LastUsedAt(); ok {
+		_spec.SetField(serviceaccount.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if sau.mutation.LastUsedAtCleared() {
+		_spec.ClearField(serviceaccount.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := sau.mutation.RevokedAt(); ok {
+		_spec.SetField(serviceaccount.FieldRevokedAt, field.TypeTime, value)
+	}
+	if sau.mutation.RevokedAtCleared() {
+		_spec.ClearField(serviceaccount.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := sau.mutation.UpdatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sau.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OwnerTable,
+			Columns: []string{serviceaccount.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OwnerTable,
+			Columns: []string{serviceaccount.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{serviceaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// ServiceAccountUpdateOne is the builder for updating a single ServiceAccount entity.
+type ServiceAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ServiceAccountMutation
+}
+
+// SetName sets the "name" field.
+func (sauo *ServiceAccountUpdateOne) SetName(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetName(s)
+	return sauo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableName(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetName(*s)
+	}
+	return sauo
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (sauo *ServiceAccountUpdateOne) SetKeyPrefix(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetKeyPrefix(s)
+	return sauo
+}
+
+// SetNillableKeyPrefix sets the "key_prefix" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableKeyPrefix(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetKeyPrefix(*s)
+	}
+	return sauo
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (sauo *ServiceAccountUpdateOne) SetKeyHash(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetKeyHash(s)
+	return sauo
+}
+
+// SetNillableKeyHash sets the "key_hash" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableKeyHash(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetKeyHash(*s)
+	}
+	return sauo
+}
+
+// SetClientCertIdentity sets the "client_cert_identity" field.
+func (sauo *ServiceAccountUpdateOne) SetClientCertIdentity(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetClientCertIdentity(s)
+	return sauo
+}
+
+// SetNillableClientCertIdentity sets the "client_cert_identity" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableClientCertIdentity(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetClientCertIdentity(*s)
+	}
+	return sauo
+}
+
+// ClearClientCertIdentity clears the value of the "client_cert_identity" field.
+func (sauo *ServiceAccountUpdateOne) ClearClientCertIdentity() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearClientCertIdentity()
+	return sauo
+}
+
+// SetScopes sets the "scopes" field.
+func (sauo *ServiceAccountUpdateOne) SetScopes(s []string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetScopes(s)
+	return sauo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (sauo *ServiceAccountUpdateOne) AppendScopes(s []string) *ServiceAccountUpdateOne {
+	sauo.mutation.AppendScopes(s)
+	return sauo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (sauo *ServiceAccountUpdateOne) ClearScopes() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearScopes()
+	return sauo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sauo *ServiceAccountUpdateOne) SetExpiresAt(t time.Time) *ServiceAccountUpdateOne {
+	sauo.mutation.SetExpiresAt(t)
+	return sauo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableExpiresAt(t *time.Time) *ServiceAccountUpdateOne {
+	if t != nil {
+		sauo.SetExpiresAt(*t)
+	}
+	return sauo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (sauo *ServiceAccountUpdateOne) ClearExpiresAt() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearExpiresAt()
+	return sauo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (sauo *ServiceAccountUpdateOne) SetLastUsedAt(t time.Time) *ServiceAccountUpdateOne {
+	sauo.mutation.SetLastUsedAt(t)
+	return sauo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableLastUsedAt(t *time.Time) *ServiceAccountUpdateOne {
+	if t != nil {
+		sauo.SetLastUsedAt(*t)
+	}
+	return sauo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (sauo *ServiceAccountUpdateOne) ClearLastUsedAt() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearLastUsedAt()
+	return sauo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (sauo *ServiceAccountUpdateOne) SetRevokedAt(t time.Time) *ServiceAccountUpdateOne {
+	sauo.mutation.SetRevokedAt(t)
+	return sauo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableRevokedAt(t *time.Time) *ServiceAccountUpdateOne {
+	if t != nil {
+		sauo.SetRevokedAt(*t)
+	}
+	return sauo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (sauo *ServiceAccountUpdateOne) ClearRevokedAt() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearRevokedAt()
+	return sauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sauo *ServiceAccountUpdateOne) SetUpdatedAt(t time.Time) *ServiceAccountUpdateOne {
+	sauo.mutation.SetUpdatedAt(t)
+	return sauo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (sauo *ServiceAccountUpdateOne) SetOwnerID(id string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetOwnerID(id)
+	return sauo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (sauo *ServiceAccountUpdateOne) SetOwner(u *User) *ServiceAccountUpdateOne {
+	return sauo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the ServiceAccountMutation object of the builder.
+func (sauo *ServiceAccountUpdateOne) Mutation() *ServiceAccountMutation {
+	return sauo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (sauo *ServiceAccountUpdateOne) ClearOwner() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearOwner()
+	return sauo
+}
+
+// Where appends a list predicates to the ServiceAccountUpdate builder.
+func (sauo *ServiceAccountUpdateOne) Where(ps ...predicate.ServiceAccount) *ServiceAccountUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *ServiceAccountUpdateOne) Select(field string, fields ...string) *ServiceAccountUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated ServiceAccount entity.
+func (sauo *ServiceAccountUpdateOne) Save(ctx context.Context) (*ServiceAccount, error) {
+	sauo.defaults()
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *ServiceAccountUpdateOne) SaveX(ctx context.Context) *ServiceAccount {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *ServiceAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *ServiceAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sauo *ServiceAccountUpdateOne) defaults() {
+	if _, ok := sauo.mutation.UpdatedAt(); !ok {
+		v := serviceaccount.UpdateDefaultUpdatedAt()
+		sauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *ServiceAccountUpdateOne) check() error {
+	if sauo.mutation.OwnerCleared() && len(sauo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ServiceAccount.owner"`)
+	}
+	return nil
+}
+
+func (sauo *ServiceAccountUpdateOne) sqlSave(ctx context.Context) (_node *ServiceAccount, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(serviceaccount.Table, serviceaccount.Columns, sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeString))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ServiceAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, serviceaccount.FieldID)
+		for _, f := range fields {
+			if !serviceaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != serviceaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.Name(); ok {
+		_spec.SetField(serviceaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.KeyPrefix(); ok {
+		_spec.SetField(serviceaccount.FieldKeyPrefix, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.KeyHash(); ok {
+		_spec.SetField(serviceaccount.FieldKeyHash, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.ClientCertIdentity(); ok {
+		_spec.SetField(serviceaccount.FieldClientCertIdentity, field.TypeString, value)
+	}
+	if sauo.mutation.ClientCertIdentityCleared() {
+		_spec.ClearField(serviceaccount.FieldClientCertIdentity, field.TypeString)
+	}
+	if value, ok := sauo.mutation.Scopes(); ok {
+		_spec.SetField(serviceaccount.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := sauo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serviceaccount.FieldScopes, value)
+		})
+	}
+	if sauo.mutation.ScopesCleared() {
+		_spec.ClearField(serviceaccount.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := sauo.mutation.ExpiresAt(); ok {
+		_spec.SetField(serviceaccount.FieldExpiresAt, field.TypeTime, value)
+	}
+	if sauo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(serviceaccount.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := sauo.mutation.LastUsedAt(); ok {
+		_spec.SetField(serviceaccount.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(serviceaccount.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := sauo.mutation.RevokedAt(); ok {
+		_spec.SetField(serviceaccount.FieldRevokedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.RevokedAtCleared() {
+		_spec.ClearField(serviceaccount.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := sauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OwnerTable,
+			Columns: []string{serviceaccount.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OwnerTable,
+			Columns: []string{serviceaccount.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ServiceAccount{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{serviceaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
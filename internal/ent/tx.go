@@ -12,10 +12,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessGrant is the client for interacting with the AccessGrant builders.
+	AccessGrant *AccessGrantClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// ConnectSession is the client for interacting with the ConnectSession builders.
+	ConnectSession *ConnectSessionClient
+	// Credential is the client for interacting with the Credential builders.
+	Credential *CredentialClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
 	// Influencer is the client for interacting with the Influencer builders.
 	Influencer *InfluencerClient
+	// Membership is the client for interacting with the Membership builders.
+	Membership *MembershipClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// ServiceAccount is the client for interacting with the ServiceAccount builders.
+	ServiceAccount *ServiceAccountClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -149,8 +167,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessGrant = NewAccessGrantClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.ConnectSession = NewConnectSessionClient(tx.config)
+	tx.Credential = NewCredentialClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
 	tx.Influencer = NewInfluencerClient(tx.config)
+	tx.Membership = NewMembershipClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
+	tx.ServiceAccount = NewServiceAccountClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -161,7 +188,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Influencer.QueryXXX(), the query will be executed
+// applies a query, for example: AccessGrant.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
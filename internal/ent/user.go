@@ -39,9 +39,17 @@ type User struct {
 type UserEdges struct {
 	// Influencers holds the value of the influencers edge.
 	Influencers []*Influencer `json:"influencers,omitempty"`
+	// ServiceAccounts holds the value of the service_accounts edge.
+	ServiceAccounts []*ServiceAccount `json:"service_accounts,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*Membership `json:"memberships,omitempty"`
+	// AccessGrants holds the value of the access_grants edge.
+	AccessGrants []*AccessGrant `json:"access_grants,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [5]bool
 }
 
 // InfluencersOrErr returns the Influencers value or an error if the edge
@@ -53,6 +61,42 @@ func (e UserEdges) InfluencersOrErr() ([]*Influencer, error) {
 	return nil, &NotLoadedError{edge: "influencers"}
 }
 
+// ServiceAccountsOrErr returns the ServiceAccounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ServiceAccountsOrErr() ([]*ServiceAccount, error) {
+	if e.loadedTypes[1] {
+		return e.ServiceAccounts, nil
+	}
+	return nil, &NotLoadedError{edge: "service_accounts"}
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MembershipsOrErr() ([]*Membership, error) {
+	if e.loadedTypes[2] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// AccessGrantsOrErr returns the AccessGrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccessGrantsOrErr() ([]*AccessGrant, error) {
+	if e.loadedTypes[3] {
+		return e.AccessGrants, nil
+	}
+	return nil, &NotLoadedError{edge: "access_grants"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[4] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -137,6 +181,26 @@ func (u *User) QueryInfluencers() *InfluencerQuery {
 	return NewUserClient(u.config).QueryInfluencers(u)
 }
 
+// QueryServiceAccounts queries the "service_accounts" edge of the User entity.
+func (u *User) QueryServiceAccounts() *ServiceAccountQuery {
+	return NewUserClient(u.config).QueryServiceAccounts(u)
+}
+
+// QueryMemberships queries the "memberships" edge of the User entity.
+func (u *User) QueryMemberships() *MembershipQuery {
+	return NewUserClient(u.config).QueryMemberships(u)
+}
+
+// QueryAccessGrants queries the "access_grants" edge of the User entity.
+func (u *User) QueryAccessGrants() *AccessGrantQuery {
+	return NewUserClient(u.config).QueryAccessGrants(u)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (u *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(u.config).QueryNotifications(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
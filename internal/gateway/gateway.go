@@ -0,0 +1,104 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/connect"
+	"github.com/WuPinYi/SocialForge/internal/idempotency"
+	"github.com/WuPinYi/SocialForge/internal/ratelimit"
+	"github.com/WuPinYi/SocialForge/internal/requestinfo"
+	"github.com/WuPinYi/SocialForge/internal/server"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// OpenAPIPath is where the OpenAPI document is served
+const OpenAPIPath = "/openapi.yaml"
+
+// bufferSize is the size of the in-process connection's buffers
+const bufferSize = 1 << 20
+
+// forwardedHeaders are the HTTP request headers passed to the API as
+// metadata under the same name. Authorization is always forwarded, and the
+// W3C trace context headers so that calls join the caller's trace.
+var forwardedHeaders = map[string]bool{
+	"Traceparent": true,
+	"Tracestate":  true,
+	textproto.CanonicalMIMEHeaderKey(auth.APIKeyHeader):           true,
+	textproto.CanonicalMIMEHeaderKey(server.OrganizationHeader):   true,
+	textproto.CanonicalMIMEHeaderKey(requestinfo.RequestIDHeader): true,
+	textproto.CanonicalMIMEHeaderKey(idempotency.Header):          true,
+}
+
+// returnedHeaders are the response metadata returned as HTTP headers under
+// the same name
+var returnedHeaders = map[string]bool{
+	requestinfo.RequestIDHeader: true,
+	ratelimit.RetryAfterHeader:  true,
+	idempotency.ReplayedHeader:  true,
+	connect.SetCookieHeader:     true,
+}
+
+// New returns a handler serving the API as JSON over HTTP, along with its
+// OpenAPI document. Requests are translated into calls on srv over an
+// in-process connection, so they pass through the same interceptors as
+// gRPC calls and are authenticated and rate limited the same way. srv must
+// not have transport credentials; New serves it until it is stopped.
+//
+// Errors are returned as a JSON google.rpc.Status with the HTTP status
+// mapped from the gRPC code, e.g. 404 for NOT_FOUND and 429 for
+// RESOURCE_EXHAUSTED.
+func New(ctx context.Context, srv *grpc.Server) (http.Handler, error) {
+	lis := bufconn.Listen(bufferSize)
+	go srv.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the API: %v", err)
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+	)
+	if err := ocsv1.RegisterOpinionControlServiceHandler(ctx, mux, conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to register the gateway: %v", err)
+	}
+
+	handler := http.NewServeMux()
+	handler.Handle("/v1/", mux)
+	handler.HandleFunc("GET "+OpenAPIPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write(ocsv1.OpenAPI)
+	})
+	return handler, nil
+}
+
+func incomingHeader(key string) (string, bool) {
+	if forwardedHeaders[textproto.CanonicalMIMEHeaderKey(key)] {
+		return key, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func outgoingHeader(key string) (string, bool) {
+	if returnedHeaders[key] {
+		return key, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,148 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	"github.com/WuPinYi/SocialForge/internal/requestinfo"
+	"github.com/WuPinYi/SocialForge/internal/server"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// newTestGateway serves a gateway for a server whose interceptor records
+// the incoming metadata, returns headers and authenticates callers with the
+// API key "alice"
+func newTestGateway(t *testing.T) (*httptest.Server, func() metadata.MD) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+
+	var mu sync.Mutex
+	var incoming metadata.MD
+	srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		mu.Lock()
+		incoming = md
+		mu.Unlock()
+
+		grpc.SetHeader(ctx, metadata.Pairs(requestinfo.RequestIDHeader, "req-1", "x-internal", "yes"))
+		if keys := md.Get(auth.APIKeyHeader); len(keys) > 0 && keys[0] == "alice" {
+			ctx = auth.NewContext(ctx, &auth.Principal{Kind: auth.PrincipalUser, Subject: "auth0|alice", UserID: "user-alice"})
+		}
+		return handler(ctx, req)
+	}))
+	ocsv1.RegisterOpinionControlServiceServer(srv, server.NewServer(client))
+	t.Cleanup(srv.Stop)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	handler, err := New(ctx, srv)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	return ts, func() metadata.MD {
+		mu.Lock()
+		defer mu.Unlock()
+		return incoming
+	}
+}
+
+func TestGatewayHeaders(t *testing.T) {
+	ts, incoming := newTestGateway(t)
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/v1/influencers", nil)
+	req.Header.Set("X-Api-Key", "alice")
+	req.Header.Set("X-Organization-Id", "org-1")
+	req.Header.Set("Traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	req.Header.Set("X-Unlisted", "dropped")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	// Alice isn't a member of the requested organization
+	if resp.StatusCode != http.StatusForbidden {
+		body, _ := io.ReadAll(resp.Body)
+		t.Fatalf("status = %d, want 403: %s", resp.StatusCode, body)
+	}
+
+	md := incoming()
+	for _, key := range []string{auth.APIKeyHeader, server.OrganizationHeader, "traceparent"} {
+		if len(md.Get(key)) == 0 {
+			t.Errorf("header %s was not forwarded", key)
+		}
+	}
+	if len(md.Get("x-unlisted")) > 0 {
+		t.Error("unlisted header was forwarded under its own name")
+	}
+
+	if got := resp.Header.Get("X-Request-Id"); got != "req-1" {
+		t.Errorf("X-Request-Id = %q, want req-1", got)
+	}
+	if got := resp.Header.Get("Grpc-Metadata-X-Internal"); got != "yes" {
+		t.Errorf("Grpc-Metadata-X-Internal = %q, want yes", got)
+	}
+	if got := resp.Header.Get("X-Internal"); got != "" {
+		t.Errorf("X-Internal = %q, want it returned only with the metadata prefix", got)
+	}
+}
+
+func TestGatewayErrors(t *testing.T) {
+	ts, _ := newTestGateway(t)
+
+	resp, err := http.Get(ts.URL + "/v1/influencers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401", resp.StatusCode)
+	}
+	var body struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if codes.Code(body.Code) != codes.Unauthenticated || body.Message == "" {
+		t.Errorf("error = %+v, want UNAUTHENTICATED with a message", body)
+	}
+}
+
+func TestGatewayOpenAPI(t *testing.T) {
+	ts, _ := newTestGateway(t)
+
+	resp, err := http.Get(ts.URL + OpenAPIPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "application/yaml" {
+		t.Errorf("Content-Type = %q, want application/yaml", got)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "/v1/influencers") {
+		t.Error("OpenAPI document does not describe /v1/influencers")
+	}
+}
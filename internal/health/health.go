@@ -0,0 +1,167 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// PostWorkerService is the health service reporting on the post worker
+const PostWorkerService = "socialforge.PostWorker"
+
+// HTTP paths of the liveness and readiness checks
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+)
+
+// checkInterval is how often the database and workers are checked
+const checkInterval = 10 * time.Second
+
+// pingTimeout bounds a database ping
+const pingTimeout = 5 * time.Second
+
+// Checker serves grpc.health.v1. The server, reported as "" and under the
+// names of the services it serves, is NOT_SERVING until the migrations have
+// run and while the database can't be reached. Each worker is reported
+// under its own name and is NOT_SERVING when its last successful tick is
+// too long ago.
+type Checker struct {
+	server   *health.Server
+	db       *sql.DB
+	services []string
+	migrated atomic.Bool
+
+	mu      sync.Mutex
+	workers map[string]worker
+}
+
+type worker struct {
+	lastSuccess func() time.Time
+	maxAge      time.Duration
+}
+
+// NewChecker creates a checker pinging db. services are the names of the
+// gRPC services whose status is the server's.
+func NewChecker(db *sql.DB, services ...string) *Checker {
+	c := &Checker{
+		server:   health.NewServer(),
+		db:       db,
+		services: append([]string{""}, services...),
+		workers:  make(map[string]worker),
+	}
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	return c
+}
+
+// Register registers the health service on s
+func (c *Checker) Register(s *grpc.Server) {
+	healthpb.RegisterHealthServer(s, c.server)
+}
+
+// AddWorker reports a worker as service. It is serving while lastSuccess,
+// the time of its last successful tick, is at most maxAge ago.
+func (c *Checker) AddWorker(service string, lastSuccess func() time.Time, maxAge time.Duration) {
+	c.mu.Lock()
+	c.workers[service] = worker{lastSuccess: lastSuccess, maxAge: maxAge}
+	c.mu.Unlock()
+	c.server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// SetMigrated records that the migrations have run and checks the server
+// right away
+func (c *Checker) SetMigrated(ctx context.Context) {
+	c.migrated.Store(true)
+	c.check(ctx)
+}
+
+// Start checks the database and workers until ctx is done, then reports
+// everything as NOT_SERVING for the rest of the shutdown
+func (c *Checker) Start(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	c.check(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			c.server.Shutdown()
+			return
+		case <-ticker.C:
+			c.check(ctx)
+		}
+	}
+}
+
+func (c *Checker) check(ctx context.Context) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if c.migrated.Load() {
+		pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+		err := c.db.PingContext(pingCtx)
+		cancel()
+		if err == nil {
+			status = healthpb.HealthCheckResponse_SERVING
+		} else {
+			log.Printf("health: database ping failed: %v", err)
+		}
+	}
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for service, w := range c.workers {
+		status := healthpb.HealthCheckResponse_NOT_SERVING
+		if last := w.lastSuccess(); !last.IsZero() && time.Since(last) <= w.maxAge {
+			status = healthpb.HealthCheckResponse_SERVING
+		}
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// Handler serves the liveness check, which succeeds as long as the process
+// answers, and the readiness check, which succeeds while the server is
+// SERVING. The readiness body lists the status of the server and of every
+// worker.
+func (c *Checker) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+LivenessPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc("GET "+ReadinessPath, func(w http.ResponseWriter, r *http.Request) {
+		status := c.status(r.Context(), "")
+		if status != healthpb.HealthCheckResponse_SERVING {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		fmt.Fprintf(w, "server: %s\n", status)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for _, service := range slices.Sorted(maps.Keys(c.workers)) {
+			fmt.Fprintf(w, "%s: %s\n", service, c.status(r.Context(), service))
+		}
+	})
+	return mux
+}
+
+func (c *Checker) status(ctx context.Context, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := c.server.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+	return resp.Status
+}
@@ -0,0 +1,109 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// get returns the status code and body of a GET to path
+func get(t *testing.T, h http.Handler, path string) (int, string) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	body, _ := io.ReadAll(rec.Result().Body)
+	return rec.Code, string(body)
+}
+
+func TestChecker(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+
+	c := NewChecker(db, "ocs.v1.OpinionControlService")
+	var lastTick atomic.Int64
+	c.AddWorker(PostWorkerService, func() time.Time {
+		if n := lastTick.Load(); n != 0 {
+			return time.Unix(0, n)
+		}
+		return time.Time{}
+	}, time.Minute)
+	h := c.Handler()
+
+	// Nothing is served before the migrations have run, but the process is
+	// alive
+	if code, _ := get(t, h, LivenessPath); code != http.StatusOK {
+		t.Errorf("liveness = %d, want 200", code)
+	}
+	if code, _ := get(t, h, ReadinessPath); code != http.StatusServiceUnavailable {
+		t.Errorf("readiness before migrating = %d, want 503", code)
+	}
+
+	c.SetMigrated(ctx)
+	for _, service := range []string{"", "ocs.v1.OpinionControlService"} {
+		if got := c.status(ctx, service); got != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("status of %q = %v, want SERVING", service, got)
+		}
+	}
+	code, body := get(t, h, ReadinessPath)
+	if code != http.StatusOK {
+		t.Errorf("readiness after migrating = %d, want 200", code)
+	}
+	if want := "server: SERVING\nsocialforge.PostWorker: NOT_SERVING\n"; body != want {
+		t.Errorf("readiness body = %q, want %q", body, want)
+	}
+
+	// Workers are serving while their last tick is recent
+	lastTick.Store(time.Now().UnixNano())
+	c.check(ctx)
+	if got := c.status(ctx, PostWorkerService); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("worker after a tick = %v, want SERVING", got)
+	}
+	lastTick.Store(time.Now().Add(-2 * time.Minute).UnixNano())
+	c.check(ctx)
+	if got := c.status(ctx, PostWorkerService); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("worker after a stale tick = %v, want NOT_SERVING", got)
+	}
+
+	// The server stops serving when the database can't be reached
+	db.Close()
+	c.check(ctx)
+	if code, _ := get(t, h, ReadinessPath); code != http.StatusServiceUnavailable {
+		t.Errorf("readiness without a database = %d, want 503", code)
+	}
+}
+
+func TestCheckerShutdown(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	c := NewChecker(db)
+	c.SetMigrated(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.Start(ctx)
+		close(done)
+	}()
+	cancel()
+	<-done
+
+	if got := c.status(context.Background(), ""); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("status after shutdown = %v, want NOT_SERVING", got)
+	}
+}
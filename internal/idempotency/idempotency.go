@@ -0,0 +1,252 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/idempotencykey"
+)
+
+const (
+	// Header is the request metadata carrying the idempotency key
+	Header = "idempotency-key"
+	// ReplayedHeader is set on responses replayed from an earlier call
+	ReplayedHeader = "idempotency-replayed"
+)
+
+// DefaultRetention is how long keys are kept unless configured otherwise
+const DefaultRetention = 24 * time.Hour
+
+// keyField is the request field carrying the idempotency key. Only methods
+// whose request has it accept a key.
+const keyField = "idempotency_key"
+
+// maxKeyLength is the longest key accepted
+const maxKeyLength = 255
+
+// abandonAfter is how long a call may run before its key is considered
+// abandoned, e.g. by a replica that crashed, and can be used again
+const abandonAfter = time.Minute
+
+// Store makes create calls idempotent. A call with a key is recorded with a
+// hash of its request and, once it succeeds, its response. A retry with the
+// same key and request gets the recorded response without running the
+// handler again; one with a different request is rejected. Failed calls
+// aren't recorded, so they can be retried with the same key.
+type Store struct {
+	client    *ent.Client
+	retention time.Duration
+}
+
+// NewStore creates a store that keeps keys for retention
+func NewStore(client *ent.Client, retention time.Duration) *Store {
+	return &Store{
+		client:    client,
+		retention: retention,
+	}
+}
+
+// UnaryInterceptor replays or records calls made with an idempotency key. It
+// must run after the auth interceptor, since keys are scoped to the caller.
+func (s *Store) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName(keyField)
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return handler(ctx, req)
+	}
+	key, err := requestKey(ctx, msg, fd)
+	if err != nil {
+		return nil, err
+	}
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if key == "" || err != nil {
+		return handler(ctx, req)
+	}
+
+	record, replay, err := s.reserve(ctx, callerKey(principal), info.FullMethod, key, requestHash(msg, fd))
+	if err != nil {
+		return nil, err
+	}
+	if replay != nil {
+		grpc.SetHeader(ctx, metadata.Pairs(ReplayedHeader, "true"))
+		return replay, nil
+	}
+
+	// Record the response, or release the key if the call failed. This
+	// happens even if the caller went away, so its retry finds the key in
+	// a final state.
+	resp, err := handler(ctx, req)
+	saveCtx := context.WithoutCancel(ctx)
+	if err != nil {
+		s.release(saveCtx, record)
+		return nil, err
+	}
+	if err := s.save(saveCtx, record, resp); err != nil {
+		log.Printf("idempotency: %v", err)
+		s.release(saveCtx, record)
+	}
+	return resp, nil
+}
+
+// Start deletes expired keys every hour until ctx is done
+func (s *Store) Start(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.client.IdempotencyKey.Delete().
+				Where(idempotencykey.ExpiresAtLT(time.Now())).
+				Exec(ctx); err != nil {
+				log.Printf("Error deleting expired idempotency keys: %v", err)
+			}
+		}
+	}
+}
+
+// reserve records a call with key. If the key was already used, it returns
+// the recorded response instead, or an error if the call is still running
+// or had a different request.
+func (s *Store) reserve(ctx context.Context, caller, method, key string, hash []byte) (*ent.IdempotencyKey, proto.Message, error) {
+	// Retry once after an expired or abandoned key was removed
+	for range 2 {
+		now := time.Now()
+		record, err := s.client.IdempotencyKey.Create().
+			SetID(uuid.New().String()).
+			SetCaller(caller).
+			SetMethod(method).
+			SetKey(key).
+			SetRequestHash(hash).
+			SetExpiresAt(now.Add(s.retention)).
+			Save(ctx)
+		if err == nil {
+			return record, nil, nil
+		}
+		if !ent.IsConstraintError(err) {
+			return nil, nil, status.Errorf(codes.Internal, "failed to record idempotency key: %v", err)
+		}
+
+		existing, err := s.client.IdempotencyKey.Query().
+			Where(
+				idempotencykey.Caller(caller),
+				idempotencykey.Method(method),
+				idempotencykey.Key(key),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to look up idempotency key: %v", err)
+		}
+
+		pending := len(existing.Response) == 0
+		if existing.ExpiresAt.Before(now) || (pending && existing.CreatedAt.Before(now.Add(-abandonAfter))) {
+			if err := s.client.IdempotencyKey.DeleteOneID(existing.ID).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+				return nil, nil, status.Errorf(codes.Internal, "failed to delete idempotency key: %v", err)
+			}
+			continue
+		}
+		if !bytes.Equal(existing.RequestHash, hash) {
+			return nil, nil, status.Error(codes.InvalidArgument, "the idempotency key was already used with a different request")
+		}
+		if pending {
+			return nil, nil, status.Error(codes.Aborted, "a call with this idempotency key is still in progress; retry later")
+		}
+
+		var recorded anypb.Any
+		if err := proto.Unmarshal(existing.Response, &recorded); err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to read recorded response: %v", err)
+		}
+		resp, err := recorded.UnmarshalNew()
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to read recorded response: %v", err)
+		}
+		return nil, resp, nil
+	}
+	return nil, nil, status.Error(codes.Aborted, "the idempotency key was used concurrently; retry")
+}
+
+// save records the response of a successful call
+func (s *Store) save(ctx context.Context, record *ent.IdempotencyKey, resp interface{}) error {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return fmt.Errorf("response of type %T can't be recorded", resp)
+	}
+	recorded, err := anypb.New(msg)
+	if err != nil {
+		return fmt.Errorf("failed to wrap response: %v", err)
+	}
+	data, err := proto.Marshal(recorded)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %v", err)
+	}
+	if err := s.client.IdempotencyKey.UpdateOne(record).SetResponse(data).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record response: %v", err)
+	}
+	return nil
+}
+
+// release removes the key of a failed call so it can be retried
+func (s *Store) release(ctx context.Context, record *ent.IdempotencyKey) {
+	if err := s.client.IdempotencyKey.DeleteOne(record).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		log.Printf("idempotency: failed to release key: %v", err)
+	}
+}
+
+// requestKey returns the key passed in the header or the request field. If
+// both are set they must match.
+func requestKey(ctx context.Context, msg proto.Message, fd protoreflect.FieldDescriptor) (string, error) {
+	key := msg.ProtoReflect().Get(fd).String()
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(Header); len(values) > 0 {
+			if key != "" && key != values[0] {
+				return "", status.Errorf(codes.InvalidArgument, "the %s header and %s differ", Header, keyField)
+			}
+			key = values[0]
+		}
+	}
+	if len(key) > maxKeyLength {
+		return "", status.Errorf(codes.InvalidArgument, "the idempotency key can be at most %d characters", maxKeyLength)
+	}
+	return key, nil
+}
+
+// requestHash hashes the request without its key, so that passing the key
+// in the header or the field makes no difference
+func requestHash(msg proto.Message, fd protoreflect.FieldDescriptor) []byte {
+	msg = proto.Clone(msg)
+	msg.ProtoReflect().Clear(fd)
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// callerKey identifies whose keys a call uses
+func callerKey(principal *auth.Principal) string {
+	if principal.IsServiceAccount() {
+		return "service_account:" + principal.ServiceAccountID
+	}
+	return "user:" + principal.Subject
+}
@@ -0,0 +1,99 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	store := NewStore(client, time.Hour)
+
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Kind: auth.PrincipalUser, Subject: "auth0|alice"})
+	info := &grpc.UnaryServerInfo{FullMethod: "/ocs.v1.OpinionControlService/CreateInfluencer"}
+
+	// The handler creates a new influencer on every call, or fails while
+	// fail is set
+	calls := 0
+	var fail error
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if fail != nil {
+			return nil, fail
+		}
+		return &ocsv1.CreateInfluencerResponse{
+			Influencer: &ocsv1.Influencer{Id: fmt.Sprintf("inf-%d", calls)},
+		}, nil
+	}
+	call := func(ctx context.Context, req *ocsv1.CreateInfluencerRequest) (*ocsv1.CreateInfluencerResponse, error) {
+		resp, err := store.UnaryInterceptor(ctx, req, info, handler)
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*ocsv1.CreateInfluencerResponse), nil
+	}
+	req := &ocsv1.CreateInfluencerRequest{Name: "Alice", Platform: "x", IdempotencyKey: "key-1"}
+
+	// A failed call releases the key
+	fail = errors.New("unavailable")
+	if _, err := call(ctx, req); !errors.Is(err, fail) {
+		t.Fatalf("failing call: got %v, want %v", err, fail)
+	}
+	fail = nil
+
+	first, err := call(ctx, req)
+	if err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+
+	// A retry, with the key in the header this time, replays the response
+	headerReq := proto.Clone(req).(*ocsv1.CreateInfluencerRequest)
+	headerReq.IdempotencyKey = ""
+	headerCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(Header, "key-1"))
+	retry, err := call(headerCtx, headerReq)
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if !proto.Equal(retry, first) || calls != 2 {
+		t.Errorf("retry: got %v after %d calls, want %v replayed", retry, calls, first)
+	}
+
+	// The key can't be used for another request
+	other := &ocsv1.CreateInfluencerRequest{Name: "Bob", Platform: "x", IdempotencyKey: "key-1"}
+	if _, err := call(ctx, other); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("other request: got %v, want INVALID_ARGUMENT", err)
+	}
+
+	// Keys are scoped to the caller
+	bobCtx := auth.NewContext(context.Background(), &auth.Principal{Kind: auth.PrincipalUser, Subject: "auth0|bob"})
+	if resp, err := call(bobCtx, req); err != nil || proto.Equal(resp, first) {
+		t.Errorf("other caller: got %v, %v, want a new influencer", resp, err)
+	}
+
+	// Calls without a key always run
+	before := calls
+	req.IdempotencyKey = ""
+	for range 2 {
+		if _, err := call(ctx, req); err != nil {
+			t.Fatalf("call without key: %v", err)
+		}
+	}
+	if calls != before+2 {
+		t.Errorf("calls without key: got %d calls, want %d", calls-before, 2)
+	}
+}
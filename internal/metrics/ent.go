@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	entgo "entgo.io/ent"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+)
+
+// Interceptor returns an ent interceptor that records the duration of every
+// query, including edge traversals and eager loading
+func Interceptor() ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			start := time.Now()
+			v, err := next.Query(ctx, q)
+			typ, op := "unknown", "unknown"
+			if qc := entgo.QueryFromContext(ctx); qc != nil {
+				typ, op = qc.Type, qc.Op
+			}
+			entQueryDuration.WithLabelValues(typ, op).Observe(time.Since(start).Seconds())
+			return v, err
+		})
+	})
+}
+
+// Hook returns an ent hook that records the duration of every mutation. It
+// should be registered first so that the time of the other hooks, and of
+// the changes they make, is included.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			// The hooks may change the operation, like a soft delete
+			// becoming an update; it is recorded as called
+			op := strings.TrimPrefix(m.Op().String(), "Op")
+			start := time.Now()
+			v, err := next.Mutate(ctx, m)
+			entMutationDuration.WithLabelValues(m.Type(), op).Observe(time.Since(start).Seconds())
+			return v, err
+		})
+	}
+}
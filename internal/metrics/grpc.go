@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryInterceptor counts unary calls and records their latency. It should
+// come first in the chain so that calls rejected by the other interceptors
+// are counted too.
+func UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	service, method := splitMethod(info.FullMethod)
+	grpcDuration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+	grpcHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	return resp, err
+}
+
+// StreamInterceptor counts streaming calls. Their duration is how long the
+// client watched, so it isn't recorded.
+func StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, ss)
+	service, method := splitMethod(info.FullMethod)
+	grpcHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	return err
+}
+
+// splitMethod splits "/package.Service/Method" into its service and method
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
@@ -0,0 +1,160 @@
+// Package metrics collects the Prometheus metrics of the server: gRPC
+// calls, ent queries and mutations, and the publishing pipeline. They are
+// served in the text exposition format at Path.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Path is where the HTTP server serves the metrics
+const Path = "/metrics"
+
+// namespace prefixes the names of all metrics
+const namespace = "socialforge"
+
+// registry holds the metrics of this package, and those of the Go runtime
+// and the process
+var registry = prometheus.NewRegistry()
+
+// publishLagBuckets go from a second to a day: a post is late by about the
+// worker interval when all is well, and by much more when it had to be
+// retried
+var publishLagBuckets = []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800, 3600, 4 * 3600, 24 * 3600}
+
+var (
+	grpcHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "handled_total",
+		Help:      "gRPC calls completed, by method and status code.",
+	}, []string{"service", "method", "code"})
+
+	grpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "handling_seconds",
+		Help:      "Latency of unary gRPC calls, by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method"})
+
+	entQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ent",
+		Name:      "query_duration_seconds",
+		Help:      "Duration of ent queries, by entity type and operation, e.g. Post and All.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"type", "op"})
+
+	entMutationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ent",
+		Name:      "mutation_duration_seconds",
+		Help:      "Duration of ent mutations including their hooks, by entity type and operation, e.g. Post and Create.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"type", "op"})
+
+	duePosts = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "publish",
+		Name:      "due_posts",
+		Help:      "Scheduled posts that were due at the post worker's last tick.",
+	})
+
+	publishLag = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "publish",
+		Name:      "lag_seconds",
+		Help:      "Time between a post's scheduled time and its publishing, by platform.",
+		Buckets:   publishLagBuckets,
+	}, []string{"platform"})
+
+	publishAttempts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "publish",
+		Name:      "attempts_total",
+		Help:      "Publishing attempts, by platform and outcome: posted or failed.",
+	}, []string{"platform", "outcome"})
+
+	publishRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "publish",
+		Name:      "retries_total",
+		Help:      "Publishing attempts of posts whose previous attempt failed, by platform.",
+	}, []string{"platform"})
+
+	workerTickDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "publish",
+		Name:      "worker_tick_duration_seconds",
+		Help:      "Time the post worker took to process the due posts.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	workerTickErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "publish",
+		Name:      "worker_tick_errors_total",
+		Help:      "Post worker ticks that failed to find the due posts.",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		grpcHandled,
+		grpcDuration,
+		entQueryDuration,
+		entMutationDuration,
+		duePosts,
+		publishLag,
+		publishAttempts,
+		publishRetries,
+		workerTickDuration,
+		workerTickErrors,
+	)
+}
+
+// Handler serves the metrics
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// Publishing outcomes
+const (
+	OutcomePosted = "posted"
+	OutcomeFailed = "failed"
+)
+
+// SetDuePosts records the number of posts due at a post worker tick
+func SetDuePosts(n int) {
+	duePosts.Set(float64(n))
+}
+
+// ObservePublish records a publishing attempt on platform. retry is set if
+// the previous attempt of the post failed. lag, how late the post is, is
+// only recorded for posted ones.
+func ObservePublish(platform, outcome string, retry bool, lag time.Duration) {
+	publishAttempts.WithLabelValues(platform, outcome).Inc()
+	if retry {
+		publishRetries.WithLabelValues(platform).Inc()
+	}
+	if outcome == OutcomePosted {
+		publishLag.WithLabelValues(platform).Observe(lag.Seconds())
+	}
+}
+
+// ObserveWorkerTick records how long a post worker tick took and whether it
+// failed
+func ObserveWorkerTick(d time.Duration, err error) {
+	workerTickDuration.Observe(d.Seconds())
+	if err != nil {
+		workerTickErrors.Inc()
+	}
+}
@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+)
+
+func TestSplitMethod(t *testing.T) {
+	tests := []struct {
+		fullMethod      string
+		service, method string
+	}{
+		{fullMethod: "/ocs.v1.OpinionControlService/ListPosts", service: "ocs.v1.OpinionControlService", method: "ListPosts"},
+		{fullMethod: "ocs.v1.OpinionControlService/ListPosts", service: "ocs.v1.OpinionControlService", method: "ListPosts"},
+		{fullMethod: "ListPosts", service: "unknown", method: "ListPosts"},
+	}
+	for _, tt := range tests {
+		service, method := splitMethod(tt.fullMethod)
+		if service != tt.service || method != tt.method {
+			t.Errorf("splitMethod(%q) = %q, %q, want %q, %q", tt.fullMethod, service, method, tt.service, tt.method)
+		}
+	}
+}
+
+func TestGRPCInterceptors(t *testing.T) {
+	unary := &grpc.UnaryServerInfo{FullMethod: "/test.Metrics/Unary"}
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	denied := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.PermissionDenied, "denied")
+	}
+	for _, handler := range []grpc.UnaryHandler{ok, ok, denied} {
+		UnaryInterceptor(context.Background(), nil, unary, handler)
+	}
+	if got := testutil.ToFloat64(grpcHandled.WithLabelValues("test.Metrics", "Unary", "OK")); got != 2 {
+		t.Errorf("OK calls = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(grpcHandled.WithLabelValues("test.Metrics", "Unary", "PermissionDenied")); got != 1 {
+		t.Errorf("PermissionDenied calls = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(grpcDuration, "socialforge_grpc_handling_seconds"); got == 0 {
+		t.Error("no latency recorded")
+	}
+
+	stream := &grpc.StreamServerInfo{FullMethod: "/test.Metrics/Stream"}
+	StreamInterceptor(nil, nil, stream, func(srv interface{}, ss grpc.ServerStream) error {
+		return status.Error(codes.Canceled, "client went away")
+	})
+	if got := testutil.ToFloat64(grpcHandled.WithLabelValues("test.Metrics", "Stream", "Canceled")); got != 1 {
+		t.Errorf("Canceled streams = %v, want 1", got)
+	}
+}
+
+func TestObservePublish(t *testing.T) {
+	ObservePublish("test-publish", OutcomePosted, false, 2*time.Second)
+	ObservePublish("test-publish", OutcomeFailed, false, time.Minute)
+	ObservePublish("test-publish", OutcomePosted, true, time.Hour)
+
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "posted", got: testutil.ToFloat64(publishAttempts.WithLabelValues("test-publish", OutcomePosted)), want: 2},
+		{name: "failed", got: testutil.ToFloat64(publishAttempts.WithLabelValues("test-publish", OutcomeFailed)), want: 1},
+		{name: "retries", got: testutil.ToFloat64(publishRetries.WithLabelValues("test-publish")), want: 1},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	// Only posted attempts record their lag
+	want := `
+# HELP socialforge_publish_lag_seconds Time between a post's scheduled time and its publishing, by platform.
+# TYPE socialforge_publish_lag_seconds histogram
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="1"} 0
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="5"} 1
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="15"} 1
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="30"} 1
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="60"} 1
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="120"} 1
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="300"} 1
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="600"} 1
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="1800"} 1
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="3600"} 2
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="14400"} 2
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="86400"} 2
+socialforge_publish_lag_seconds_bucket{platform="test-publish",le="+Inf"} 2
+socialforge_publish_lag_seconds_sum{platform="test-publish"} 3602
+socialforge_publish_lag_seconds_count{platform="test-publish"} 2
+`
+	if err := testutil.CollectAndCompare(publishLag, strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestObserveWorkerTick(t *testing.T) {
+	before := testutil.ToFloat64(workerTickErrors)
+	ObserveWorkerTick(time.Second, nil)
+	ObserveWorkerTick(time.Second, errors.New("database unavailable"))
+	if got := testutil.ToFloat64(workerTickErrors) - before; got != 1 {
+		t.Errorf("tick errors = %v, want 1", got)
+	}
+
+	SetDuePosts(7)
+	if got := testutil.ToFloat64(duePosts); got != 7 {
+		t.Errorf("due posts = %v, want 7", got)
+	}
+}
+
+func TestEntMetrics(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	client.Use(Hook())
+	client.Intercept(Interceptor())
+	ctx := context.Background()
+
+	queries := observations(t, entQueryDuration.WithLabelValues("User", "All"))
+	u := client.User.Create().SetID("user-1").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	client.User.UpdateOne(u).SetName("Alice B").ExecX(ctx)
+	client.User.Query().AllX(ctx)
+
+	if got := observations(t, entMutationDuration.WithLabelValues("User", "Create")); got != 1 {
+		t.Errorf("User creates = %d, want 1", got)
+	}
+	if got := observations(t, entMutationDuration.WithLabelValues("User", "UpdateOne")); got != 1 {
+		t.Errorf("User updates = %d, want 1", got)
+	}
+	if got := observations(t, entQueryDuration.WithLabelValues("User", "All")) - queries; got != 1 {
+		t.Errorf("User queries = %d, want 1", got)
+	}
+}
+
+// observations returns the number of values observed by a histogram
+func observations(t *testing.T, o prometheus.Observer) uint64 {
+	t.Helper()
+	var m dto.Metric
+	if err := o.(prometheus.Metric).Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestHandler(t *testing.T) {
+	SetDuePosts(3)
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	body, _ := io.ReadAll(rec.Result().Body)
+	for _, want := range []string{"socialforge_publish_due_posts 3", "go_goroutines"} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("metrics lack %q", want)
+		}
+	}
+}
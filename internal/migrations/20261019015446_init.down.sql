@@ -0,0 +1,20 @@
+-- reverse: create index "post_status" to table: "posts"
+DROP INDEX "post_status";
+-- reverse: create index "post_influencer_id_scheduled_time" to table: "posts"
+DROP INDEX "post_influencer_id_scheduled_time";
+-- reverse: create "posts" table
+DROP TABLE "posts";
+-- reverse: create index "influencer_platform_account_id" to table: "influencers"
+DROP INDEX "influencer_platform_account_id";
+-- reverse: create "influencers" table
+DROP TABLE "influencers";
+-- reverse: create index "user_auth0_id" to table: "users"
+DROP INDEX "user_auth0_id";
+-- reverse: create index "user_email" to table: "users"
+DROP INDEX "user_email";
+-- reverse: create index "users_auth0_id_key" to table: "users"
+DROP INDEX "users_auth0_id_key";
+-- reverse: create index "users_email_key" to table: "users"
+DROP INDEX "users_email_key";
+-- reverse: create "users" table
+DROP TABLE "users";
@@ -0,0 +1,20 @@
+-- create "users" table
+CREATE TABLE "users" ("id" character varying NOT NULL, "email" character varying NOT NULL, "name" character varying NOT NULL, "auth0_id" character varying NOT NULL, "role" character varying NOT NULL DEFAULT 'user', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX "users_email_key" ON "users" ("email");
+-- create index "users_auth0_id_key" to table: "users"
+CREATE UNIQUE INDEX "users_auth0_id_key" ON "users" ("auth0_id");
+-- create index "user_email" to table: "users"
+CREATE UNIQUE INDEX "user_email" ON "users" ("email");
+-- create index "user_auth0_id" to table: "users"
+CREATE UNIQUE INDEX "user_auth0_id" ON "users" ("auth0_id");
+-- create "influencers" table
+CREATE TABLE "influencers" ("id" character varying NOT NULL, "name" character varying NOT NULL, "platform" character varying NOT NULL, "account_id" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'active', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_influencers" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "influencers_users_influencers" FOREIGN KEY ("user_influencers") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "influencer_platform_account_id" to table: "influencers"
+CREATE UNIQUE INDEX "influencer_platform_account_id" ON "influencers" ("platform", "account_id");
+-- create "posts" table
+CREATE TABLE "posts" ("id" character varying NOT NULL, "content" text NOT NULL, "scheduled_time" timestamptz NOT NULL, "status" character varying NOT NULL DEFAULT 'scheduled', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "influencer_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "posts_influencers_posts" FOREIGN KEY ("influencer_id") REFERENCES "influencers" ("id") ON DELETE NO ACTION);
+-- create index "post_influencer_id_scheduled_time" to table: "posts"
+CREATE INDEX "post_influencer_id_scheduled_time" ON "posts" ("influencer_id", "scheduled_time");
+-- create index "post_status" to table: "posts"
+CREATE INDEX "post_status" ON "posts" ("status");
@@ -0,0 +1,66 @@
+-- reverse: create index "service_accounts_client_cert_identity_key" to table: "service_accounts"
+DROP INDEX "service_accounts_client_cert_identity_key";
+-- reverse: create index "service_accounts_key_prefix_key" to table: "service_accounts"
+DROP INDEX "service_accounts_key_prefix_key";
+-- reverse: create "service_accounts" table
+DROP TABLE "service_accounts";
+-- reverse: create index "notification_user_id_read_at" to table: "notifications"
+DROP INDEX "notification_user_id_read_at";
+-- reverse: create "notifications" table
+DROP TABLE "notifications";
+-- reverse: create index "membership_user_id" to table: "memberships"
+DROP INDEX "membership_user_id";
+-- reverse: create index "membership_organization_id_user_id" to table: "memberships"
+DROP INDEX "membership_organization_id_user_id";
+-- reverse: create "memberships" table
+DROP TABLE "memberships";
+-- reverse: create index "credential_key_version" to table: "credentials"
+DROP INDEX "credential_key_version";
+-- reverse: create index "credentials_influencer_id_key" to table: "credentials"
+DROP INDEX "credentials_influencer_id_key";
+-- reverse: create "credentials" table
+DROP TABLE "credentials";
+-- reverse: create index "accessgrant_user_id" to table: "access_grants"
+DROP INDEX "accessgrant_user_id";
+-- reverse: create index "accessgrant_influencer_id_user_id" to table: "access_grants"
+DROP INDEX "accessgrant_influencer_id_user_id";
+-- reverse: create "access_grants" table
+DROP TABLE "access_grants";
+-- reverse: drop index "user_email" from table: "users"
+CREATE UNIQUE INDEX "user_email" ON "users" ("email");
+-- reverse: drop index "user_auth0_id" from table: "users"
+CREATE UNIQUE INDEX "user_auth0_id" ON "users" ("auth0_id");
+-- reverse: modify "users" table
+ALTER TABLE "users" ALTER COLUMN "email" SET NOT NULL;
+-- reverse: create index "influencer_organization_id" to table: "influencers"
+DROP INDEX "influencer_organization_id";
+-- reverse: create index "influencer_deleted_at" to table: "influencers"
+DROP INDEX "influencer_deleted_at";
+-- reverse: modify "influencers" table
+ALTER TABLE "influencers" DROP CONSTRAINT "influencers_organizations_influencers", DROP COLUMN "organization_id", DROP COLUMN "account_verified_at", DROP COLUMN "disconnect_reason", DROP COLUMN "deleted_at";
+-- reverse: create "organizations" table
+DROP TABLE "organizations";
+-- reverse: create index "idempotencykey_expires_at" to table: "idempotency_keys"
+DROP INDEX "idempotencykey_expires_at";
+-- reverse: create index "idempotencykey_caller_method_key" to table: "idempotency_keys"
+DROP INDEX "idempotencykey_caller_method_key";
+-- reverse: create "idempotency_keys" table
+DROP TABLE "idempotency_keys";
+-- reverse: create index "connectsession_expires_at" to table: "connect_sessions"
+DROP INDEX "connectsession_expires_at";
+-- reverse: create index "connect_sessions_state_key" to table: "connect_sessions"
+DROP INDEX "connect_sessions_state_key";
+-- reverse: create "connect_sessions" table
+DROP TABLE "connect_sessions";
+-- reverse: create index "auditevent_actor_id" to table: "audit_events"
+DROP INDEX "auditevent_actor_id";
+-- reverse: create index "auditevent_entity_type_entity_id" to table: "audit_events"
+DROP INDEX "auditevent_entity_type_entity_id";
+-- reverse: create index "auditevent_created_at" to table: "audit_events"
+DROP INDEX "auditevent_created_at";
+-- reverse: create "audit_events" table
+DROP TABLE "audit_events";
+-- reverse: create index "post_deleted_at" to table: "posts"
+DROP INDEX "post_deleted_at";
+-- reverse: modify "posts" table
+ALTER TABLE "posts" DROP COLUMN "deleted_at";
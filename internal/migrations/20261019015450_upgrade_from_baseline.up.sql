@@ -0,0 +1,66 @@
+-- modify "posts" table
+ALTER TABLE "posts" ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "post_deleted_at" to table: "posts"
+CREATE INDEX "post_deleted_at" ON "posts" ("deleted_at");
+-- create "audit_events" table
+CREATE TABLE "audit_events" ("id" character varying NOT NULL, "actor_kind" character varying NOT NULL, "actor_id" character varying NULL, "actor_subject" character varying NULL, "service_account_id" character varying NULL, "operation" character varying NOT NULL, "entity_type" character varying NOT NULL, "entity_id" character varying NOT NULL, "changes" jsonb NULL, "request_id" character varying NULL, "client_ip" character varying NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "auditevent_created_at" to table: "audit_events"
+CREATE INDEX "auditevent_created_at" ON "audit_events" ("created_at");
+-- create index "auditevent_entity_type_entity_id" to table: "audit_events"
+CREATE INDEX "auditevent_entity_type_entity_id" ON "audit_events" ("entity_type", "entity_id");
+-- create index "auditevent_actor_id" to table: "audit_events"
+CREATE INDEX "auditevent_actor_id" ON "audit_events" ("actor_id");
+-- create "connect_sessions" table
+CREATE TABLE "connect_sessions" ("id" character varying NOT NULL, "state" character varying NOT NULL, "code_verifier" character varying NOT NULL, "platform" character varying NOT NULL, "user_id" character varying NOT NULL, "organization_id" character varying NOT NULL, "influencer_id" character varying NULL, "name" character varying NULL, "expires_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "connect_sessions_state_key" to table: "connect_sessions"
+CREATE UNIQUE INDEX "connect_sessions_state_key" ON "connect_sessions" ("state");
+-- create index "connectsession_expires_at" to table: "connect_sessions"
+CREATE INDEX "connectsession_expires_at" ON "connect_sessions" ("expires_at");
+-- create "idempotency_keys" table
+CREATE TABLE "idempotency_keys" ("id" character varying NOT NULL, "caller" character varying NOT NULL, "method" character varying NOT NULL, "key" character varying NOT NULL, "request_hash" bytea NOT NULL, "response" bytea NULL, "expires_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "idempotencykey_caller_method_key" to table: "idempotency_keys"
+CREATE UNIQUE INDEX "idempotencykey_caller_method_key" ON "idempotency_keys" ("caller", "method", "key");
+-- create index "idempotencykey_expires_at" to table: "idempotency_keys"
+CREATE INDEX "idempotencykey_expires_at" ON "idempotency_keys" ("expires_at");
+-- create "organizations" table
+CREATE TABLE "organizations" ("id" character varying NOT NULL, "name" character varying NOT NULL, "personal" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- modify "influencers" table
+ALTER TABLE "influencers" ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "disconnect_reason" character varying NULL, ADD COLUMN "account_verified_at" timestamptz NULL, ADD COLUMN "organization_id" character varying NULL, ADD CONSTRAINT "influencers_organizations_influencers" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE SET NULL;
+-- create index "influencer_deleted_at" to table: "influencers"
+CREATE INDEX "influencer_deleted_at" ON "influencers" ("deleted_at");
+-- create index "influencer_organization_id" to table: "influencers"
+CREATE INDEX "influencer_organization_id" ON "influencers" ("organization_id");
+-- modify "users" table
+ALTER TABLE "users" ALTER COLUMN "email" DROP NOT NULL;
+-- drop index "user_auth0_id" from table: "users"
+DROP INDEX "user_auth0_id";
+-- drop index "user_email" from table: "users"
+DROP INDEX "user_email";
+-- create "access_grants" table
+CREATE TABLE "access_grants" ("id" character varying NOT NULL, "level" character varying NOT NULL DEFAULT 'viewer', "expires_at" timestamptz NULL, "granted_by" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "influencer_id" character varying NOT NULL, "user_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "access_grants_influencers_access_grants" FOREIGN KEY ("influencer_id") REFERENCES "influencers" ("id") ON DELETE NO ACTION, CONSTRAINT "access_grants_users_access_grants" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "accessgrant_influencer_id_user_id" to table: "access_grants"
+CREATE UNIQUE INDEX "accessgrant_influencer_id_user_id" ON "access_grants" ("influencer_id", "user_id");
+-- create index "accessgrant_user_id" to table: "access_grants"
+CREATE INDEX "accessgrant_user_id" ON "access_grants" ("user_id");
+-- create "credentials" table
+CREATE TABLE "credentials" ("id" character varying NOT NULL, "ciphertext" bytea NOT NULL, "wrapped_key" bytea NOT NULL, "key_version" bigint NOT NULL, "expires_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "influencer_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "credentials_influencers_credential" FOREIGN KEY ("influencer_id") REFERENCES "influencers" ("id") ON DELETE NO ACTION);
+-- create index "credentials_influencer_id_key" to table: "credentials"
+CREATE UNIQUE INDEX "credentials_influencer_id_key" ON "credentials" ("influencer_id");
+-- create index "credential_key_version" to table: "credentials"
+CREATE INDEX "credential_key_version" ON "credentials" ("key_version");
+-- create "memberships" table
+CREATE TABLE "memberships" ("id" character varying NOT NULL, "role" character varying NOT NULL DEFAULT 'viewer', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "organization_id" character varying NOT NULL, "user_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "memberships_organizations_memberships" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE NO ACTION, CONSTRAINT "memberships_users_memberships" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "membership_organization_id_user_id" to table: "memberships"
+CREATE UNIQUE INDEX "membership_organization_id_user_id" ON "memberships" ("organization_id", "user_id");
+-- create index "membership_user_id" to table: "memberships"
+CREATE INDEX "membership_user_id" ON "memberships" ("user_id");
+-- create "notifications" table
+CREATE TABLE "notifications" ("id" character varying NOT NULL, "kind" character varying NOT NULL, "influencer_id" character varying NULL, "message" text NOT NULL, "read_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "user_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "notifications_users_notifications" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "notification_user_id_read_at" to table: "notifications"
+CREATE INDEX "notification_user_id_read_at" ON "notifications" ("user_id", "read_at");
+-- create "service_accounts" table
+CREATE TABLE "service_accounts" ("id" character varying NOT NULL, "name" character varying NOT NULL, "key_prefix" character varying NOT NULL, "key_hash" character varying NOT NULL, "client_cert_identity" character varying NULL, "scopes" jsonb NULL, "expires_at" timestamptz NULL, "last_used_at" timestamptz NULL, "revoked_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_service_accounts" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "service_accounts_users_service_accounts" FOREIGN KEY ("user_service_accounts") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "service_accounts_key_prefix_key" to table: "service_accounts"
+CREATE UNIQUE INDEX "service_accounts_key_prefix_key" ON "service_accounts" ("key_prefix");
+-- create index "service_accounts_client_cert_identity_key" to table: "service_accounts"
+CREATE UNIQUE INDEX "service_accounts_client_cert_identity_key" ON "service_accounts" ("client_cert_identity");
@@ -0,0 +1,4 @@
+-- reverse: create index "influencers_name_search" to table: "influencers"
+DROP INDEX "influencers_name_search";
+-- reverse: create index "posts_content_search" to table: "posts"
+DROP INDEX "posts_content_search";
@@ -0,0 +1,4 @@
+-- create index "posts_content_search" to table: "posts"
+CREATE INDEX IF NOT EXISTS "posts_content_search" ON "posts" USING GIN (to_tsvector('english', "content"));
+-- create index "influencers_name_search" to table: "influencers"
+CREATE INDEX IF NOT EXISTS "influencers_name_search" ON "influencers" USING GIN (to_tsvector('english', "name"));
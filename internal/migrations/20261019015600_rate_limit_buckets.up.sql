@@ -0,0 +1,3 @@
+-- create "rate_limit_buckets" table; it is unlogged since losing the buckets
+-- in a crash only resets the limits
+CREATE UNLOGGED TABLE IF NOT EXISTS "rate_limit_buckets" ("key" text NOT NULL, "tokens" double precision NOT NULL, "allowed" boolean NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("key"));
@@ -0,0 +1,2 @@
+-- modify "posts" table
+ALTER TABLE "posts" ADD COLUMN "trace_context" jsonb NULL;
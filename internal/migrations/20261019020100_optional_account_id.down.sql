@@ -0,0 +1,2 @@
+-- reverse: modify "influencers" table
+ALTER TABLE "influencers" ALTER COLUMN "account_id" SET NOT NULL;
@@ -0,0 +1,2 @@
+-- modify "influencers" table
+ALTER TABLE "influencers" ALTER COLUMN "account_id" DROP NOT NULL;
@@ -0,0 +1,2 @@
+-- reverse: modify "connect_sessions" table
+ALTER TABLE "connect_sessions" DROP COLUMN "redirect_url";
@@ -0,0 +1,2 @@
+-- modify "connect_sessions" table
+ALTER TABLE "connect_sessions" ADD COLUMN "redirect_url" character varying NULL;
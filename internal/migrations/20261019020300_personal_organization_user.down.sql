@@ -0,0 +1,4 @@
+-- reverse: create index "organizations_personal_user_id_key" to table: "organizations"
+DROP INDEX "organizations_personal_user_id_key";
+-- reverse: modify "organizations" table
+ALTER TABLE "organizations" DROP COLUMN "personal_user_id";
@@ -0,0 +1,15 @@
+-- modify "organizations" table
+ALTER TABLE "organizations" ADD COLUMN "personal_user_id" character varying NULL;
+-- backfill "personal_user_id" with the user each personal organization was
+-- created for; of the duplicates a race created, the oldest is kept as the
+-- user's personal organization
+UPDATE "organizations" AS "o" SET "personal_user_id" = "p"."user_id"
+FROM (
+  SELECT DISTINCT ON ("m"."user_id") "m"."user_id", "m"."organization_id"
+  FROM "memberships" AS "m" JOIN "organizations" AS "po" ON "po"."id" = "m"."organization_id"
+  WHERE "po"."personal" AND "m"."role" = 'owner'
+  ORDER BY "m"."user_id", "m"."created_at", "m"."organization_id"
+) AS "p"
+WHERE "o"."id" = "p"."organization_id";
+-- create index "organizations_personal_user_id_key" to table: "organizations"
+CREATE UNIQUE INDEX "organizations_personal_user_id_key" ON "organizations" ("personal_user_id");
@@ -0,0 +1,147 @@
+// Package migrations holds the versioned SQL migrations of the database
+// and applies them.
+//
+// The migrations are generated from the ent schema with diff.go, in the
+// golang-migrate format, and committed. Objects ent can't describe, like
+// the full-text search indexes, are written by hand in the same format.
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// lockTimeout is how long to wait for another process running migrations
+const lockTimeout = 5 * time.Minute
+
+// ErrNoChange is returned by Up when every migration has been applied
+var ErrNoChange = migrate.ErrNoChange
+
+// Migration is one versioned migration
+type Migration struct {
+	Version uint
+	Name    string
+	Applied bool
+}
+
+// Status is the state of the database schema
+type Status struct {
+	// Current is the version of the last applied migration, or 0 if none
+	// has been
+	Current uint
+	// Latest is the version of the last migration of this build
+	Latest uint
+	// Dirty is set when a migration failed halfway. It has to be fixed by
+	// hand and the version forced before migrating again.
+	Dirty      bool
+	Migrations []Migration
+}
+
+// Behind reports whether migrations of this build haven't been applied
+func (s *Status) Behind() bool {
+	return s.Current < s.Latest
+}
+
+// Migrator applies the migrations to a database. Up, Down and Force hold a
+// Postgres advisory lock, so that only one process migrates at a time.
+type Migrator struct {
+	m *migrate.Migrate
+}
+
+// New creates a migrator for the database at dsn. It opens its own
+// connection, which Close closes.
+func New(dsn string) (*Migrator, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %v", err)
+	}
+	driver, err := postgres.WithInstance(db, &postgres.Config{})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to database: %v", err)
+	}
+	src, err := iofs.New(files, ".")
+	if err != nil {
+		driver.Close()
+		return nil, fmt.Errorf("failed to read migrations: %v", err)
+	}
+	m, err := migrate.NewWithInstance("iofs", src, "postgres", driver)
+	if err != nil {
+		driver.Close()
+		return nil, err
+	}
+	m.LockTimeout = lockTimeout
+	return &Migrator{m: m}, nil
+}
+
+// Up applies every pending migration. It returns ErrNoChange if there were
+// none.
+func (m *Migrator) Up() error {
+	return m.m.Up()
+}
+
+// Down reverts the last steps migrations
+func (m *Migrator) Down(steps int) error {
+	return m.m.Steps(-steps)
+}
+
+// Force records version as the current one without running anything, and
+// clears the dirty flag. It is used to recover from a failed migration
+// once it has been fixed by hand.
+func (m *Migrator) Force(version uint) error {
+	return m.m.Force(int(version))
+}
+
+// Status returns the state of the schema
+func (m *Migrator) Status() (*Status, error) {
+	current, dirty, err := m.m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return nil, err
+	}
+
+	all, err := list()
+	if err != nil {
+		return nil, err
+	}
+	status := &Status{Current: current, Dirty: dirty, Migrations: all}
+	for i := range all {
+		all[i].Applied = all[i].Version <= current
+		status.Latest = all[i].Version
+	}
+	return status, nil
+}
+
+// Close closes the connection to the database
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+// list returns the migrations of this build in order
+func list() ([]Migration, error) {
+	entries, err := fs.Glob(files, "*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+	var all []Migration
+	for _, name := range entries {
+		m, err := source.Parse(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file %s: %v", name, err)
+		}
+		all = append(all, Migration{Version: m.Version, Name: m.Identifier})
+	}
+	return all, nil
+}
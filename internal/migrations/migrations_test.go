@@ -0,0 +1,100 @@
+package migrations
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"testing"
+
+	atlasmigrate "ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	_ "github.com/lib/pq"
+)
+
+// testDatabaseEnv names an empty Postgres database to run the migrations
+// on. The tests using it are skipped without it.
+const testDatabaseEnv = "TEST_DATABASE_URL"
+
+func TestList(t *testing.T) {
+	all, err := list()
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(all) == 0 {
+		t.Fatal("no migrations")
+	}
+	for i, m := range all {
+		if i > 0 && m.Version <= all[i-1].Version {
+			t.Errorf("migration %d_%s is out of order after %d", m.Version, m.Name, all[i-1].Version)
+		}
+		if m.Name == "" {
+			t.Errorf("migration %d has no name", m.Version)
+		}
+		down := fmt.Sprintf("%d_%s.down.sql", m.Version, m.Name)
+		if _, err := fs.Stat(files, down); err != nil {
+			t.Errorf("migration %d_%s has no down migration: %v", m.Version, m.Name, err)
+		}
+	}
+
+	downs, _ := fs.Glob(files, "*.down.sql")
+	if len(downs) != len(all) {
+		t.Errorf("%d down migrations for %d migrations", len(downs), len(all))
+	}
+}
+
+func TestSum(t *testing.T) {
+	dir, err := sqltool.NewGolangMigrateDir(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := atlasmigrate.Validate(dir); err != nil {
+		t.Errorf("atlas.sum is out of date; run go run -mod=mod internal/migrations/diff.go -sum: %v", err)
+	}
+}
+
+func TestUpDown(t *testing.T) {
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDatabaseEnv)
+	}
+	m, err := New(dsn)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer m.Close()
+
+	status := func() *Status {
+		t.Helper()
+		s, err := m.Status()
+		if err != nil {
+			t.Fatalf("Status: %v", err)
+		}
+		return s
+	}
+	if s := status(); s.Current != 0 {
+		t.Fatalf("%s must be an empty database, it is at version %d", testDatabaseEnv, s.Current)
+	}
+
+	// Apply every migration, revert them all and apply them again, so each
+	// down migration undoes its up migration cleanly
+	for round := range 2 {
+		if err := m.Up(); err != nil {
+			t.Fatalf("Up (round %d): %v", round+1, err)
+		}
+		s := status()
+		if s.Current != s.Latest || s.Dirty || s.Behind() {
+			t.Fatalf("after Up: at %d of %d, dirty %v", s.Current, s.Latest, s.Dirty)
+		}
+		if err := m.Up(); !errors.Is(err, ErrNoChange) {
+			t.Errorf("Up without pending migrations: %v, want ErrNoChange", err)
+		}
+
+		if err := m.Down(len(s.Migrations)); err != nil {
+			t.Fatalf("Down (round %d): %v", round+1, err)
+		}
+		if s := status(); s.Current != 0 || s.Dirty {
+			t.Fatalf("after Down: at %d, dirty %v", s.Current, s.Dirty)
+		}
+	}
+}
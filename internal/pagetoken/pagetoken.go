@@ -0,0 +1,128 @@
+package pagetoken
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalid is returned for tokens that are malformed, were not signed
+// with the current key, or belong to a different query
+var ErrInvalid = errors.New("invalid page token")
+
+// Token is the position of a page within a query
+type Token struct {
+	// Query identifies the query the token belongs to, so a token can't be
+	// used with a different filter or ordering
+	Query string `json:"q"`
+	// After holds the sort key values of the last row of the previous page
+	After []string `json:"a"`
+}
+
+// Signer encodes tokens and verifies them when they come back
+type Signer struct {
+	key []byte
+}
+
+// NewSigner creates a signer using key
+func NewSigner(key []byte) *Signer {
+	return &Signer{key: key}
+}
+
+// NewRandomSigner creates a signer with a random key. Its tokens are only
+// valid in this process.
+func NewRandomSigner() *Signer {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(fmt.Sprintf("failed to generate page token key: %v", err))
+	}
+	return NewSigner(key)
+}
+
+// ParseSigner creates a signer from a base64 encoded key. All replicas must
+// share the key for tokens to work across them.
+func ParseSigner(encoded string) (*Signer, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, err
+	}
+	if len(key) < 32 {
+		return nil, fmt.Errorf("key must be at least 32 bytes, got %d", len(key))
+	}
+	return NewSigner(key), nil
+}
+
+// Encode returns the opaque, signed form of t
+func (s *Signer) Encode(t *Token) string {
+	payload, err := json.Marshal(t)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode page token: %v", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(append(payload, s.sign(payload)...))
+}
+
+// Decode verifies a token returned by Encode and checks that it belongs to
+// query
+func (s *Signer) Decode(token, query string) (*Token, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) < sha256.Size {
+		return nil, ErrInvalid
+	}
+	payload, mac := b[:len(b)-sha256.Size], b[len(b)-sha256.Size:]
+	if !hmac.Equal(mac, s.sign(payload)) {
+		return nil, ErrInvalid
+	}
+
+	var t Token
+	if err := json.Unmarshal(payload, &t); err != nil {
+		return nil, ErrInvalid
+	}
+	if t.Query != query {
+		return nil, ErrInvalid
+	}
+	return &t, nil
+}
+
+func (s *Signer) sign(payload []byte) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write(payload)
+	return h.Sum(nil)
+}
+
+// FormatValue encodes a sort key value, a string or a time.Time, so that
+// ParseValue restores its type
+func FormatValue(v any) string {
+	switch v := v.(type) {
+	case time.Time:
+		return "t" + v.Format(time.RFC3339Nano)
+	case string:
+		return "s" + v
+	default:
+		panic(fmt.Sprintf("unsupported sort key type %T", v))
+	}
+}
+
+// ParseValue decodes a value encoded by FormatValue
+func ParseValue(s string) (any, error) {
+	if s == "" {
+		return nil, ErrInvalid
+	}
+	switch s[0] {
+	case 't':
+		t, err := time.Parse(time.RFC3339Nano, s[1:])
+		if err != nil {
+			return nil, ErrInvalid
+		}
+		return t, nil
+	case 's':
+		return s[1:], nil
+	default:
+		return nil, ErrInvalid
+	}
+}
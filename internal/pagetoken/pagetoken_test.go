@@ -0,0 +1,89 @@
+package pagetoken
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecode(t *testing.T) {
+	s := NewSigner([]byte(strings.Repeat("k", 32)))
+	token := s.Encode(&Token{Query: "status = \"scheduled\"|created_at desc", After: []string{"sa", "sb"}})
+
+	got, err := s.Decode(token, "status = \"scheduled\"|created_at desc")
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(got.After) != 2 || got.After[0] != "sa" || got.After[1] != "sb" {
+		t.Errorf("After = %q, want [sa sb]", got.After)
+	}
+
+	// Flip one bit anywhere in the token, in the payload or the signature
+	raw, _ := base64.RawURLEncoding.DecodeString(token)
+	for _, i := range []int{0, len(raw) / 2, len(raw) - 1} {
+		tampered := append([]byte(nil), raw...)
+		tampered[i] ^= 1
+		if _, err := s.Decode(base64.RawURLEncoding.EncodeToString(tampered), "status = \"scheduled\"|created_at desc"); !errors.Is(err, ErrInvalid) {
+			t.Errorf("token tampered at byte %d: got %v, want ErrInvalid", i, err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		query string
+	}{
+		{name: "different query", token: token, query: "status = \"paused\"|created_at desc"},
+		{name: "different order", token: token, query: "status = \"scheduled\"|created_at asc"},
+		{name: "other key", token: NewSigner([]byte(strings.Repeat("x", 32))).Encode(&Token{Query: "q"}), query: "q"},
+		{name: "not base64", token: "!!!", query: "q"},
+		{name: "too short", token: base64.RawURLEncoding.EncodeToString([]byte("short")), query: "q"},
+	}
+	for _, tt := range tests {
+		if _, err := s.Decode(tt.token, tt.query); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: got %v, want ErrInvalid", tt.name, err)
+		}
+	}
+}
+
+func TestParseSigner(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	s, err := ParseSigner(key + "\n")
+	if err != nil {
+		t.Fatalf("ParseSigner: %v", err)
+	}
+	// Replicas sharing the key accept each other's tokens
+	token := NewSigner([]byte(strings.Repeat("k", 32))).Encode(&Token{Query: "q"})
+	if _, err := s.Decode(token, "q"); err != nil {
+		t.Errorf("Decode with a parsed key: %v", err)
+	}
+
+	if _, err := ParseSigner(base64.StdEncoding.EncodeToString([]byte("short"))); err == nil {
+		t.Error("ParseSigner accepted a short key")
+	}
+}
+
+func TestValue(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 30, 0, 123456789, time.UTC)
+	for _, v := range []any{now, "post-1", ""} {
+		got, err := ParseValue(FormatValue(v))
+		if err != nil {
+			t.Fatalf("ParseValue(FormatValue(%v)): %v", v, err)
+		}
+		if tm, ok := v.(time.Time); ok {
+			if !tm.Equal(got.(time.Time)) {
+				t.Errorf("time = %v, want %v", got, tm)
+			}
+		} else if got != v {
+			t.Errorf("value = %v, want %v", got, v)
+		}
+	}
+
+	for _, s := range []string{"", "x1", "tnot a time"} {
+		if _, err := ParseValue(s); !errors.Is(err, ErrInvalid) {
+			t.Errorf("ParseValue(%q): got %v, want ErrInvalid", s, err)
+		}
+	}
+}
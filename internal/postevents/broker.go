@@ -0,0 +1,256 @@
+package postevents
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+)
+
+// Event kinds
+const (
+	KindCreated       = "created"
+	KindRescheduled   = "rescheduled"
+	KindStatusChanged = "status_changed"
+	KindPublished     = "published"
+	KindFailed        = "failed"
+)
+
+// Default limits of a broker
+const (
+	// DefaultHistory is the number of past events kept for resuming
+	DefaultHistory = 10000
+	// DefaultBacklog is the number of events a subscriber can fall behind
+	// before it is dropped
+	DefaultBacklog = 1000
+)
+
+var (
+	// ErrCursorExpired is returned when resuming from a cursor whose event
+	// is no longer in the history, or that was issued by another process
+	ErrCursorExpired = errors.New("cursor expired")
+	// ErrInvalidCursor is returned for malformed cursors
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrTooSlow ends a subscription that fell too far behind
+	ErrTooSlow = errors.New("subscriber fell too far behind")
+)
+
+// Event is a change to a post
+type Event struct {
+	// Cursor identifies the event within the broker that published it
+	Cursor string
+	Kind   string
+	// Post is the state of the post after the change
+	Post *ent.Post
+	// Error describes why publishing failed, for KindFailed
+	Error string
+	Time  time.Time
+}
+
+// Broker fans out post events to subscribers and keeps a bounded history,
+// so a subscriber that was disconnected can resume where it left off
+type Broker struct {
+	epoch   string
+	backlog int
+
+	mu      sync.Mutex
+	seq     uint64
+	history []Event
+	next    int // position in history the next event is written to
+	subs    map[*Subscription]struct{}
+}
+
+// Option configures optional behaviour of the Broker
+type Option func(*Broker)
+
+// WithHistory sets the number of past events kept for resuming
+func WithHistory(n int) Option {
+	return func(b *Broker) {
+		b.history = make([]Event, 0, n)
+	}
+}
+
+// WithBacklog sets the number of events a subscriber can fall behind
+func WithBacklog(n int) Option {
+	return func(b *Broker) {
+		b.backlog = n
+	}
+}
+
+func NewBroker(opts ...Option) *Broker {
+	epoch := make([]byte, 4)
+	if _, err := rand.Read(epoch); err != nil {
+		panic(fmt.Sprintf("failed to generate broker epoch: %v", err))
+	}
+	b := &Broker{
+		epoch:   hex.EncodeToString(epoch),
+		backlog: DefaultBacklog,
+		history: make([]Event, 0, DefaultHistory),
+		subs:    make(map[*Subscription]struct{}),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Publish assigns e a cursor and delivers it to every subscriber. It never
+// blocks; subscribers that can't keep up are dropped.
+func (b *Broker) Publish(e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	e.Cursor = b.cursor(b.seq)
+	if cap(b.history) > 0 {
+		if len(b.history) < cap(b.history) {
+			b.history = append(b.history, e)
+		} else {
+			b.history[b.next] = e
+		}
+		b.next = (b.next + 1) % cap(b.history)
+	}
+
+	for sub := range b.subs {
+		if !sub.push(e) {
+			delete(b.subs, sub)
+		}
+	}
+}
+
+// Subscribe returns a subscription to the events published from now on.
+// With a cursor, it first replays the events published after that one.
+func (b *Broker) Subscribe(cursor string) (*Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &Subscription{
+		broker:  b,
+		backlog: b.backlog,
+		ready:   make(chan struct{}, 1),
+	}
+
+	if cursor != "" {
+		after, err := b.parseCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		missed := b.seq - after
+		if missed > uint64(len(b.history)) {
+			return nil, ErrCursorExpired
+		}
+		// The history is in order starting at next once it has wrapped
+		for i := len(b.history) - int(missed); i < len(b.history); i++ {
+			sub.queue = append(sub.queue, b.history[(b.next+i)%len(b.history)])
+		}
+		if len(sub.queue) > 0 {
+			sub.backlog += len(sub.queue)
+			sub.ready <- struct{}{}
+		}
+	}
+
+	b.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+func (b *Broker) unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subs, sub)
+}
+
+// cursor encodes a sequence number along with the broker's epoch, so that
+// cursors from before a restart, or from another replica's broker, are
+// recognized as expired
+func (b *Broker) cursor(seq uint64) string {
+	return b.epoch + "-" + strconv.FormatUint(seq, 10)
+}
+
+func (b *Broker) parseCursor(cursor string) (uint64, error) {
+	epoch, seq, ok := strings.Cut(cursor, "-")
+	if !ok {
+		return 0, ErrInvalidCursor
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	if epoch != b.epoch {
+		return 0, ErrCursorExpired
+	}
+	if n > b.seq {
+		return 0, ErrInvalidCursor
+	}
+	return n, nil
+}
+
+// Subscription receives the events of a broker in the order they were
+// published
+type Subscription struct {
+	broker  *Broker
+	backlog int
+	ready   chan struct{}
+
+	mu    sync.Mutex
+	queue []Event
+	err   error
+}
+
+// push queues e and reports whether the subscriber is still keeping up
+func (s *Subscription) push(e Event) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.queue) >= s.backlog {
+		s.queue = nil
+		s.err = ErrTooSlow
+	} else {
+		s.queue = append(s.queue, e)
+	}
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+	return s.err == nil
+}
+
+// Next waits for the next event. It returns ErrTooSlow once the subscriber
+// has fallen too far behind.
+func (s *Subscription) Next(ctx context.Context) (Event, error) {
+	for {
+		s.mu.Lock()
+		if s.err != nil {
+			s.mu.Unlock()
+			return Event{}, s.err
+		}
+		if len(s.queue) > 0 {
+			e := s.queue[0]
+			s.queue = s.queue[1:]
+			s.mu.Unlock()
+			return e, nil
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return Event{}, ctx.Err()
+		case <-s.ready:
+		}
+	}
+}
+
+// Close stops the subscription
+func (s *Subscription) Close() {
+	s.broker.unsubscribe(s)
+}
@@ -0,0 +1,110 @@
+package postevents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+)
+
+// publish publishes an event for each post ID and returns their cursors
+func publish(b *Broker, ids ...string) []string {
+	sub, _ := b.Subscribe("")
+	defer sub.Close()
+
+	var cursors []string
+	for _, id := range ids {
+		b.Publish(Event{Kind: KindCreated, Post: &ent.Post{ID: id}})
+		e, _ := sub.Next(context.Background())
+		cursors = append(cursors, e.Cursor)
+	}
+	return cursors
+}
+
+// next returns the post IDs of the next n events of sub
+func next(t *testing.T, sub *Subscription, n int) []string {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var ids []string
+	for i := 0; i < n; i++ {
+		e, err := sub.Next(ctx)
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		ids = append(ids, e.Post.ID)
+	}
+	return ids
+}
+
+func TestBrokerResume(t *testing.T) {
+	// The history holds p3 to p5, so resuming works from p2 onwards
+	tests := []struct {
+		after int
+		want  []string
+	}{
+		{after: 1, want: []string{"p3", "p4", "p5"}},
+		{after: 3, want: []string{"p5"}},
+		{after: 4, want: nil},
+	}
+	for _, tt := range tests {
+		b := NewBroker(WithHistory(3))
+		cursors := publish(b, "p1", "p2", "p3", "p4", "p5")
+
+		sub, err := b.Subscribe(cursors[tt.after])
+		if err != nil {
+			t.Fatalf("Subscribe(%s): %v", cursors[tt.after], err)
+		}
+		got := next(t, sub, len(tt.want))
+
+		// New events follow the replayed ones
+		b.Publish(Event{Kind: KindPublished, Post: &ent.Post{ID: "live"}})
+		got = append(got, next(t, sub, 1)...)
+		sub.Close()
+
+		want := append(tt.want, "live")
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("resuming after p%d: got %v, want %v", tt.after+1, got, want)
+		}
+	}
+}
+
+func TestBrokerResumeErrors(t *testing.T) {
+	b := NewBroker(WithHistory(3))
+	cursors := publish(b, "p1", "p2", "p3", "p4", "p5")
+	other := publish(NewBroker(), "p1")
+
+	tests := []struct {
+		name   string
+		cursor string
+		want   error
+	}{
+		{name: "fell out of the history", cursor: cursors[0], want: ErrCursorExpired},
+		{name: "from another broker", cursor: other[0], want: ErrCursorExpired},
+		{name: "malformed", cursor: "abc", want: ErrInvalidCursor},
+		{name: "not a number", cursor: "abc-x", want: ErrInvalidCursor},
+		{name: "not issued yet", cursor: cursors[4][:len(cursors[4])-1] + "9", want: ErrInvalidCursor},
+	}
+	for _, tt := range tests {
+		if _, err := b.Subscribe(tt.cursor); !errors.Is(err, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestBrokerDropsSlowSubscriber(t *testing.T) {
+	b := NewBroker(WithBacklog(2))
+	slow, _ := b.Subscribe("")
+	defer slow.Close()
+
+	for i := 0; i < 3; i++ {
+		b.Publish(Event{Kind: KindCreated, Post: &ent.Post{ID: fmt.Sprintf("p%d", i)}})
+	}
+	if _, err := slow.Next(context.Background()); !errors.Is(err, ErrTooSlow) {
+		t.Errorf("Next: got %v, want ErrTooSlow", err)
+	}
+}
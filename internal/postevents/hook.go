@@ -0,0 +1,112 @@
+package postevents
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+)
+
+// Hook returns an ent hook that publishes an event to b for every post that
+// is created, rescheduled or changes status, whichever code path changed it.
+// Changes made in a transaction are published once it commits.
+func Hook(b *Broker) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			pm, ok := m.(*ent.PostMutation)
+			if !ok || m.Op().Is(ent.OpDelete|ent.OpDeleteOne) {
+				return next.Mutate(ctx, m)
+			}
+			_, statusChanged := pm.Status()
+			_, rescheduled := pm.ScheduledTime()
+			if !m.Op().Is(ent.OpCreate) && !statusChanged && !rescheduled {
+				return next.Mutate(ctx, m)
+			}
+
+			// Remember the state before an update to tell what changed
+			client := pm.Client()
+			var ids []string
+			before := make(map[string]*ent.Post)
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = pm.IDs(ctx); err != nil {
+					return nil, fmt.Errorf("postevents: failed to resolve ids: %w", err)
+				}
+				if len(ids) == 0 {
+					return next.Mutate(ctx, m)
+				}
+				posts, err := client.Post.Query().Where(post.IDIn(ids...)).All(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("postevents: failed to load previous state: %w", err)
+				}
+				for _, p := range posts {
+					before[p.ID] = p
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				id, _ := pm.ID()
+				ids = []string{id}
+			}
+			after, err := client.Post.Query().Where(post.IDIn(ids...)).All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("postevents: failed to load new state: %w", err)
+			}
+
+			var events []Event
+			for _, p := range after {
+				if kind := changeKind(before[p.ID], p); kind != "" {
+					events = append(events, Event{Kind: kind, Post: p})
+				}
+			}
+			publish := func() {
+				for _, e := range events {
+					b.Publish(e)
+				}
+			}
+
+			// Hold the events back until the transaction commits, so that
+			// watchers never see changes that are rolled back
+			if tx, err := pm.Tx(); err == nil {
+				tx.OnCommit(func(next ent.Committer) ent.Committer {
+					return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+						if err := next.Commit(ctx, tx); err != nil {
+							return err
+						}
+						publish()
+						return nil
+					})
+				})
+			} else {
+				publish()
+			}
+			return v, nil
+		})
+	}
+}
+
+// changeKind returns the kind of event for a post that changed from before,
+// which is nil for a new post, to after. It returns "" if nothing changed
+// that watchers are told about.
+func changeKind(before, after *ent.Post) string {
+	switch {
+	case before == nil:
+		return KindCreated
+	case before.Status != after.Status && after.Status == "posted":
+		return KindPublished
+	case before.Status != after.Status && after.Status == "failed":
+		return KindFailed
+	case before.Status != after.Status:
+		return KindStatusChanged
+	case !before.ScheduledTime.Equal(after.ScheduledTime):
+		return KindRescheduled
+	default:
+		return ""
+	}
+}
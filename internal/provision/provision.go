@@ -0,0 +1,122 @@
+package provision
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+)
+
+// Provisioner creates User rows just in time from the token claims and keeps
+// their email and name in sync. It implements auth.UserProvisioner.
+type Provisioner struct {
+	client *ent.Client
+}
+
+// NewProvisioner creates a new user provisioner
+func NewProvisioner(client *ent.Client) *Provisioner {
+	return &Provisioner{
+		client: client,
+	}
+}
+
+// ProvisionUser implements auth.UserProvisioner
+func (p *Provisioner) ProvisionUser(ctx context.Context, principal *auth.Principal) (string, error) {
+	u, err := p.client.User.Query().Where(user.Auth0IDEQ(principal.Subject)).Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get user: %v", err)
+		}
+		u, err = p.create(ctx, principal)
+		if err != nil {
+			return "", err
+		}
+		return u.ID, nil
+	}
+
+	if err := p.sync(ctx, u, principal); err != nil {
+		return "", err
+	}
+	return u.ID, nil
+}
+
+// create inserts a new user for the principal. A concurrent first request
+// for the same subject may win the race, in which case its row is used.
+func (p *Provisioner) create(ctx context.Context, principal *auth.Principal) (*ent.User, error) {
+	create := p.client.User.Create().
+		SetID(uuid.New().String()).
+		SetName(principal.Name).
+		SetAuth0ID(principal.Subject).
+		SetRole("user")
+	if principal.Email != "" {
+		create.SetEmail(principal.Email)
+	}
+
+	u, err := create.Save(ctx)
+	if err == nil {
+		return u, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("failed to create user: %v", err)
+	}
+
+	// Either another request created the same user, or the email is
+	// already registered to a different Auth0 identity
+	u, qerr := p.client.User.Query().Where(user.Auth0IDEQ(principal.Subject)).Only(ctx)
+	if qerr == nil {
+		return u, nil
+	}
+	if !ent.IsNotFound(qerr) {
+		return nil, fmt.Errorf("failed to get user: %v", qerr)
+	}
+	return nil, status.Error(codes.FailedPrecondition, "email is already registered to another account")
+}
+
+// sync updates the stored profile when the claims have changed
+func (p *Provisioner) sync(ctx context.Context, u *ent.User, principal *auth.Principal) error {
+	update := u.Update()
+	changed := false
+	if principal.Name != "" && principal.Name != u.Name {
+		update.SetName(principal.Name)
+		changed = true
+	}
+
+	emailChanged := principal.Email != "" && principal.Email != u.Email
+	if emailChanged {
+		taken, err := p.client.User.Query().
+			Where(user.EmailEQ(principal.Email), user.IDNEQ(u.ID)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check email: %v", err)
+		}
+		if taken {
+			// Keep the old address rather than failing every request
+			// until the other account releases it
+			log.Printf("Not updating email of user %s: %s belongs to another user", u.ID, principal.Email)
+			emailChanged = false
+		} else {
+			update.SetEmail(principal.Email)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) && emailChanged {
+			log.Printf("Not updating email of user %s: %s was claimed concurrently", u.ID, principal.Email)
+			return nil
+		}
+		return fmt.Errorf("failed to update user: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/requestinfo"
+)
+
+// RetryAfterHeader is the response header carrying the number of seconds to
+// wait before retrying a rejected call
+const RetryAfterHeader = "retry-after"
+
+// Store holds the token buckets
+type Store interface {
+	// Take takes a token from the bucket at key, creating it full if it
+	// doesn't exist. If the bucket is empty it returns false and how long
+	// until a token is available.
+	Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+// Limiter enforces the configured limits on every RPC. Its interceptors
+// must run after the auth interceptor so that calls are limited per
+// principal; the peer interceptors run before it.
+type Limiter struct {
+	store  Store
+	config *Config
+}
+
+// NewLimiter creates a new rate limiter
+func NewLimiter(store Store, config *Config) *Limiter {
+	return &Limiter{
+		store:  store,
+		config: config,
+	}
+}
+
+// UnaryInterceptor rejects unary calls over the caller's limits
+func (l *Limiter) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.limit(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects streaming calls over the caller's limits
+func (l *Limiter) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.limit(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// PeerUnaryInterceptor rejects unary calls over the client IP's limit. It
+// must run after the request info interceptor and before auth.
+func (l *Limiter) PeerUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.limitPeer(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// PeerStreamInterceptor rejects streaming calls over the client IP's limit
+func (l *Limiter) PeerStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.limitPeer(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// limitPeer takes a token from the client IP's bucket. These buckets are
+// separate from the ones unauthenticated callers get per IP.
+func (l *Limiter) limitPeer(ctx context.Context) error {
+	if l.config.Peer.Burst == 0 {
+		return nil
+	}
+	info := requestinfo.FromContext(ctx)
+	if info == nil || info.ClientIP == "" {
+		return nil
+	}
+	return l.take(ctx, "peer:"+info.ClientIP, l.config.Peer, "peer")
+}
+
+// limit takes a token from the caller's bucket and, if the method has its
+// own limit, from the caller's bucket for the method
+func (l *Limiter) limit(ctx context.Context, fullMethod string) error {
+	caller := callerKey(ctx)
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+
+	if err := l.take(ctx, caller, l.config.Default, caller); err != nil {
+		return err
+	}
+	if limit, ok := l.config.Methods[method]; ok {
+		if err := l.take(ctx, caller+"|"+method, limit, method); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Limiter) take(ctx context.Context, key string, limit Limit, subject string) error {
+	ok, wait, err := l.store.Take(ctx, key, limit)
+	if err != nil {
+		// Don't turn a store outage into an API outage
+		log.Printf("rate limit: %v", err)
+		return nil
+	}
+	if ok {
+		return nil
+	}
+
+	seconds := int(math.Ceil(wait.Seconds()))
+	grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.Itoa(seconds)))
+
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("rate limit exceeded, retry in %ds", seconds))
+	st, err = st.WithDetails(
+		&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(wait),
+		},
+		&errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     subject,
+				Description: fmt.Sprintf("at most %d requests at once, refilling at %.4g per second", limit.Burst, limit.Rate),
+			}},
+		},
+	)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	return st.Err()
+}
+
+// callerKey identifies whose buckets a call is taken from: the service
+// account or user for authenticated calls, the client IP otherwise
+func callerKey(ctx context.Context) string {
+	if principal, err := auth.GetPrincipalFromContext(ctx); err == nil {
+		if principal.IsServiceAccount() {
+			return "service_account:" + principal.ServiceAccountID
+		}
+		return "user:" + principal.Subject
+	}
+	if info := requestinfo.FromContext(ctx); info != nil && info.ClientIP != "" {
+		return "ip:" + info.ClientIP
+	}
+	return "anonymous"
+}
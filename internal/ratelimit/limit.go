@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is a token bucket that refills at Rate tokens per second and holds
+// at most Burst tokens. Every request takes one token.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Config holds the limit applied to each principal across all methods, and
+// additional limits for individual methods. Methods are keyed by their
+// name without the service, e.g. "ListPosts".
+type Config struct {
+	Default Limit
+	Methods map[string]Limit
+	// Peer is the limit per client IP, taken before the call is
+	// authenticated, so that a flood of calls with bad credentials is cut
+	// off before verifying them. It is generous since many users can share
+	// an address. A Burst of 0 disables it.
+	Peer Limit
+}
+
+// DefaultConfig returns the limits used when nothing is configured. Methods
+// that create resources or are expensive get tighter limits.
+func DefaultConfig() *Config {
+	return &Config{
+		Default: Limit{Rate: 10, Burst: 600},
+		Methods: map[string]Limit{
+			"CreateInfluencer":          {Rate: 100.0 / 3600, Burst: 100},
+			"ConnectInfluencer":         {Rate: 30.0 / 3600, Burst: 30},
+			"CompleteConnectInfluencer": {Rate: 30.0 / 3600, Burst: 30},
+			"CreateOrganization":        {Rate: 20.0 / 3600, Burst: 20},
+			"CreateServiceAccount":      {Rate: 20.0 / 3600, Burst: 20},
+			"SchedulePost":              {Rate: 1000.0 / 3600, Burst: 200},
+			"ExportAuditEvents":         {Rate: 10.0 / 3600, Burst: 10},
+			"SearchPosts":               {Rate: 1, Burst: 60},
+		},
+		Peer: Limit{Rate: 50, Burst: 3000},
+	}
+}
+
+// ParseLimit parses a limit in the form "N/unit" with an optional burst,
+// "N/unit:burst". The unit is s, m or h. Without a burst, all N requests
+// of a period may be made at once.
+func ParseLimit(s string) (Limit, error) {
+	spec, burstSpec, hasBurst := strings.Cut(strings.TrimSpace(s), ":")
+	n, unit, ok := strings.Cut(spec, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("expected N/unit, got %q", s)
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(n))
+	if err != nil || count <= 0 {
+		return Limit{}, fmt.Errorf("invalid count %q", n)
+	}
+
+	var period time.Duration
+	switch strings.TrimSpace(unit) {
+	case "s":
+		period = time.Second
+	case "m":
+		period = time.Minute
+	case "h":
+		period = time.Hour
+	default:
+		return Limit{}, fmt.Errorf("invalid unit %q: expected s, m or h", unit)
+	}
+
+	l := Limit{Rate: float64(count) / period.Seconds(), Burst: count}
+	if hasBurst {
+		if l.Burst, err = strconv.Atoi(strings.TrimSpace(burstSpec)); err != nil || l.Burst <= 0 {
+			return Limit{}, fmt.Errorf("invalid burst %q", burstSpec)
+		}
+	}
+	return l, nil
+}
+
+// wait returns how long it takes for the bucket to refill from tokens to one
+func (l Limit) wait(tokens float64) time.Duration {
+	if tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - tokens) / l.Rate * float64(time.Second))
+}
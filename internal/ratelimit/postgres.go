@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// idleSweepInterval is how often buckets idle for a day are deleted. The
+// delete scans the table, so it runs less often than the memory sweep.
+const idleSweepInterval = time.Hour
+
+// PostgresStore keeps the buckets in a Postgres table so that all replicas
+// share the same limits. Every Take is a single atomic upsert. Its table,
+// rate_limit_buckets, is created by the migrations; it is unlogged, since
+// losing the buckets in a crash only resets the limits.
+type PostgresStore struct {
+	db *sql.DB
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// takeToken refills the bucket for the time since its last update, then
+// takes a token if one is available. $2 is the rate, $3 the burst. All
+// expressions in SET see the row as it was before the update.
+const takeToken = `INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+VALUES ($1, $3::double precision - 1, true, now())
+ON CONFLICT (key) DO UPDATE SET
+	tokens = CASE
+		WHEN ` + available + ` >= 1 THEN ` + available + ` - 1
+		ELSE ` + available + `
+	END,
+	allowed = ` + available + ` >= 1,
+	updated_at = now()
+RETURNING tokens, allowed`
+
+// available is the number of tokens in the bucket after refilling it
+const available = `LEAST($3::double precision, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at) * $2::double precision)`
+
+// deleteIdleBuckets removes buckets that have not been used for a day
+const deleteIdleBuckets = `DELETE FROM rate_limit_buckets WHERE updated_at < now() - interval '1 day'`
+
+// NewPostgresStore creates a Postgres-backed store and deletes the buckets
+// that have been idle for a day. Take deletes them again every
+// idleSweepInterval.
+func NewPostgresStore(ctx context.Context, db *sql.DB) (*PostgresStore, error) {
+	if _, err := db.ExecContext(ctx, deleteIdleBuckets); err != nil {
+		return nil, fmt.Errorf("failed to delete idle rate limit buckets: %v", err)
+	}
+	return &PostgresStore{db: db, lastSweep: time.Now()}, nil
+}
+
+// Take implements Store
+func (s *PostgresStore) Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	s.sweep()
+
+	var tokens float64
+	var allowed bool
+	err := s.db.QueryRowContext(ctx, takeToken, key, limit.Rate, limit.Burst).Scan(&tokens, &allowed)
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to take token: %v", err)
+	}
+	if !allowed {
+		return false, limit.wait(tokens), nil
+	}
+	return true, 0, nil
+}
+
+// sweep deletes the idle buckets in the background, at most once every
+// idleSweepInterval
+func (s *PostgresStore) sweep() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Since(s.lastSweep) < idleSweepInterval {
+		return
+	}
+	s.lastSweep = time.Now()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if _, err := s.db.ExecContext(ctx, deleteIdleBuckets); err != nil {
+			log.Printf("rate limit: failed to delete idle buckets: %v", err)
+		}
+	}()
+}
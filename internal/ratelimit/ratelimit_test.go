@@ -0,0 +1,133 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/requestinfo"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		spec string
+		want Limit
+		err  bool
+	}{
+		{spec: "10/s", want: Limit{Rate: 10, Burst: 10}},
+		{spec: "60/m:5", want: Limit{Rate: 1, Burst: 5}},
+		{spec: "3600/h", want: Limit{Rate: 1, Burst: 3600}},
+		{spec: "10", err: true},
+		{spec: "0/s", err: true},
+		{spec: "10/d", err: true},
+		{spec: "10/s:0", err: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseLimit(tt.spec)
+		if (err != nil) != tt.err {
+			t.Errorf("ParseLimit(%q): got error %v, want error %v", tt.spec, err, tt.err)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("ParseLimit(%q): got %+v, want %+v", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	limit := Limit{Rate: 1, Burst: 2}
+
+	for i := 0; i < limit.Burst; i++ {
+		if ok, _, err := store.Take(ctx, "alice", limit); err != nil || !ok {
+			t.Fatalf("take %d: got %v, %v, want allowed", i, ok, err)
+		}
+	}
+
+	ok, wait, err := store.Take(ctx, "alice", limit)
+	if err != nil || ok {
+		t.Fatalf("take past the burst: got %v, %v, want denied", ok, err)
+	}
+	if wait <= 0 || wait > time.Second {
+		t.Errorf("got wait %v, want up to a second", wait)
+	}
+
+	// Buckets are kept per key
+	if ok, _, err := store.Take(ctx, "bob", limit); err != nil || !ok {
+		t.Errorf("take for another key: got %v, %v, want allowed", ok, err)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), &Config{
+		Default: Limit{Rate: 1, Burst: 3},
+		Methods: map[string]Limit{"SearchPosts": {Rate: 1, Burst: 1}},
+	})
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Kind: auth.PrincipalUser, Subject: "auth0|alice"})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	call := func(method string) error {
+		_, err := limiter.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/ocs.v1.OpinionControlService/" + method}, handler)
+		return err
+	}
+
+	// The method's own limit is reached first
+	if err := call("SearchPosts"); err != nil {
+		t.Fatalf("first search: %v", err)
+	}
+	if err := call("SearchPosts"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("second search: got %v, want RESOURCE_EXHAUSTED", err)
+	}
+
+	// Other methods only share the default limit, which the searches took
+	// from as well
+	if err := call("ListPosts"); err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if err := call("ListPosts"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("list past the default limit: got %v, want RESOURCE_EXHAUSTED", err)
+	}
+}
+
+func TestLimiterPeer(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), &Config{
+		Default: Limit{Rate: 1, Burst: 10},
+		Peer:    Limit{Rate: 1, Burst: 2},
+	})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/ocs.v1.OpinionControlService/ListPosts"}
+	call := func(ip string) error {
+		ctx := requestinfo.NewContext(context.Background(), &requestinfo.Info{ClientIP: ip})
+		_, err := limiter.PeerUnaryInterceptor(ctx, nil, info, handler)
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := call("192.0.2.1"); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+	if err := call("192.0.2.1"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("call past the peer limit: got %v, want RESOURCE_EXHAUSTED", err)
+	}
+	if err := call("192.0.2.2"); err != nil {
+		t.Errorf("call from another address: %v", err)
+	}
+
+	// The peer buckets don't count against the caller's own limit
+	ctx := requestinfo.NewContext(context.Background(), &requestinfo.Info{ClientIP: "192.0.2.1"})
+	if _, err := limiter.UnaryInterceptor(ctx, nil, info, handler); err != nil {
+		t.Errorf("unauthenticated call from the same address: %v", err)
+	}
+
+	// A Burst of 0 disables the peer limit
+	limiter.config.Peer = Limit{}
+	if err := call("192.0.2.1"); err != nil {
+		t.Errorf("call with the peer limit disabled: %v", err)
+	}
+}
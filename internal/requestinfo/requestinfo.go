@@ -0,0 +1,138 @@
+package requestinfo
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// RequestIDHeader is the metadata key carrying the request ID. Clients may
+// set it to correlate their own logs; otherwise one is generated. It is
+// echoed back in the response header.
+const RequestIDHeader = "x-request-id"
+
+// Info describes the request being served
+type Info struct {
+	RequestID string
+	ClientIP  string
+}
+
+type infoKey struct{}
+
+// NewContext returns a copy of ctx carrying the request info
+func NewContext(ctx context.Context, info *Info) context.Context {
+	return context.WithValue(ctx, infoKey{}, info)
+}
+
+// FromContext returns the request info, or nil outside of a request
+func FromContext(ctx context.Context) *Info {
+	info, _ := ctx.Value(infoKey{}).(*Info)
+	return info
+}
+
+// Interceptor attaches the request info to calls. The client IP is the
+// peer address, unless the peer is a trusted proxy: then it is taken from
+// X-Forwarded-For, which clients can otherwise forge.
+type Interceptor struct {
+	trustedProxies []netip.Prefix
+}
+
+// NewInterceptor returns an interceptor that trusts X-Forwarded-For from
+// peers in trustedProxies. Calls from the in-process gateway are always
+// trusted, since it appends the address of the HTTP client.
+func NewInterceptor(trustedProxies []netip.Prefix) *Interceptor {
+	return &Interceptor{trustedProxies: trustedProxies}
+}
+
+// UnaryInterceptor attaches the request ID and client IP to the context
+func (i *Interceptor) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(i.withInfo(ctx), req)
+}
+
+// StreamInterceptor attaches the request ID and client IP to the stream context
+func (i *Interceptor) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &wrappedStream{ServerStream: ss, ctx: i.withInfo(ss.Context())})
+}
+
+func (i *Interceptor) withInfo(ctx context.Context) context.Context {
+	info := &Info{}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(RequestIDHeader); len(v) > 0 && v[0] != "" {
+		info.RequestID = v[0]
+	} else {
+		info.RequestID = uuid.New().String()
+	}
+	info.ClientIP = i.clientIP(ctx, md)
+
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, info.RequestID))
+	return NewContext(ctx, info)
+}
+
+// clientIP returns the peer address, or if the peer is a trusted proxy the
+// right-most X-Forwarded-For hop that isn't. Hops to the left of it were
+// written by the client and can't be relied on.
+func (i *Interceptor) clientIP(ctx context.Context, md metadata.MD) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	// Calls from the in-process gateway have no address of their own
+	ip, trusted := "", true
+	if p.Addr.Network() != "bufconn" {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+		trusted = i.trusted(ip)
+	}
+	if !trusted {
+		return ip
+	}
+
+	var hops []string
+	for _, v := range md.Get("x-forwarded-for") {
+		hops = append(hops, strings.Split(v, ",")...)
+	}
+	for j := len(hops) - 1; j >= 0; j-- {
+		hop := strings.TrimSpace(hops[j])
+		if hop == "" {
+			continue
+		}
+		ip = hop
+		if !i.trusted(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+// trusted reports whether ip is in one of the trusted proxy ranges
+func (i *Interceptor) trusted(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range i.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// wrappedStream overrides the context of a grpc.ServerStream
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
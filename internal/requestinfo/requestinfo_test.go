@@ -0,0 +1,91 @@
+package requestinfo
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// bufconnAddr is the address of in-process connections
+type bufconnAddr struct{}
+
+func (bufconnAddr) Network() string { return "bufconn" }
+func (bufconnAddr) String() string  { return "bufconn" }
+
+func TestClientIP(t *testing.T) {
+	trusted := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.0.2.1/32"),
+	}
+	tests := []struct {
+		name string
+		peer net.Addr
+		xff  []string
+		want string
+	}{
+		{
+			name: "untrusted peer",
+			peer: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 4000},
+			want: "203.0.113.7",
+		},
+		{
+			name: "forged header from untrusted peer",
+			peer: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 4000},
+			xff:  []string{"198.51.100.1"},
+			want: "203.0.113.7",
+		},
+		{
+			name: "trusted proxy",
+			peer: &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 4000},
+			xff:  []string{"198.51.100.1"},
+			want: "198.51.100.1",
+		},
+		{
+			name: "forged hop left of the client",
+			peer: &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 4000},
+			xff:  []string{"1.1.1.1, 198.51.100.1"},
+			want: "198.51.100.1",
+		},
+		{
+			name: "chain of trusted proxies",
+			peer: &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 4000},
+			xff:  []string{"1.1.1.1, 198.51.100.1, 192.0.2.1", "10.9.9.9"},
+			want: "198.51.100.1",
+		},
+		{
+			name: "trusted proxy without header",
+			peer: &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 4000},
+			want: "10.1.2.3",
+		},
+		{
+			name: "gateway",
+			peer: bufconnAddr{},
+			xff:  []string{"1.1.1.1, 203.0.113.7"},
+			want: "203.0.113.7",
+		},
+		{
+			name: "gateway behind trusted proxy",
+			peer: bufconnAddr{},
+			xff:  []string{"198.51.100.1, 10.1.2.3"},
+			want: "198.51.100.1",
+		},
+	}
+
+	i := NewInterceptor(trusted)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tt.peer})
+			md := metadata.MD{}
+			for _, v := range tt.xff {
+				md.Append("x-forwarded-for", v)
+			}
+			if got := i.clientIP(ctx, md); got != tt.want {
+				t.Errorf("clientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package search
+
+import (
+	"errors"
+	"html"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// textSearchConfig is the Postgres text search configuration documents and
+// queries are parsed with. It must match the one of the indexes created by
+// the search_indexes migration.
+const textSearchConfig = "english"
+
+// maxTerms is the most words and phrases a query can have
+const maxTerms = 20
+
+// Snippet sizes in words
+const (
+	snippetWords       = 30
+	snippetWordsBefore = 8
+)
+
+// minStemLength is the shortest query word that also highlights longer
+// words starting with it, to approximate the stemming Postgres matches with
+const minStemLength = 3
+
+// Query parse errors
+var (
+	ErrEmptyQuery   = errors.New("the query has no words")
+	ErrTooManyTerms = errors.New("the query has too many words and phrases")
+)
+
+// Query is a parsed search query. All of its terms must match.
+type Query struct {
+	terms []term
+}
+
+// term is a word or, with several words, a phrase. A prefix term matches
+// words starting with its last word.
+type term struct {
+	words  []string
+	prefix bool
+}
+
+// Parse parses a query of words, "quoted phrases" and prefixes ending
+// with *. Punctuation is ignored.
+func Parse(text string) (*Query, error) {
+	q := &Query{}
+	for {
+		text = strings.TrimLeftFunc(text, unicode.IsSpace)
+		if text == "" {
+			break
+		}
+
+		var chunk string
+		if rest, ok := strings.CutPrefix(text, `"`); ok {
+			end := strings.IndexByte(rest, '"')
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			chunk, text = rest[:end+1], rest[end+1:]
+			chunk = strings.TrimSuffix(chunk, `"`)
+		} else {
+			end := strings.IndexFunc(text, unicode.IsSpace)
+			if end < 0 {
+				end = len(text)
+			}
+			chunk, text = text[:end], text[end:]
+		}
+
+		words := splitWords(chunk)
+		if len(words) == 0 {
+			continue
+		}
+		q.terms = append(q.terms, term{words: words, prefix: strings.HasSuffix(chunk, "*")})
+	}
+
+	switch {
+	case len(q.terms) == 0:
+		return nil, ErrEmptyQuery
+	case len(q.terms) > maxTerms:
+		return nil, ErrTooManyTerms
+	}
+	return q, nil
+}
+
+// Match returns a predicate matching the rows of s whose column matches
+// the query. On Postgres it uses full-text search; elsewhere, i.e. in
+// tests on SQLite, every term must occur in the column, ignoring case.
+func (q *Query) Match(s *sql.Selector, column string) *sql.Predicate {
+	if s.Dialect() == dialect.Postgres {
+		return sql.P(func(b *sql.Builder) {
+			b.WriteString(document(s.C(column))).
+				WriteString(" @@ to_tsquery('" + textSearchConfig + "', ").
+				Arg(q.tsquery()).
+				WriteString(")")
+		})
+	}
+
+	preds := make([]*sql.Predicate, len(q.terms))
+	for i, t := range q.terms {
+		preds[i] = sql.ContainsFold(s.C(column), strings.Join(t.words, " "))
+	}
+	return sql.And(preds...)
+}
+
+// tsquery formats the query for to_tsquery. Words only hold letters and
+// digits, so they need no escaping.
+func (q *Query) tsquery() string {
+	terms := make([]string, len(q.terms))
+	for i, t := range q.terms {
+		words := make([]string, len(t.words))
+		for j, w := range t.words {
+			words[j] = "'" + w + "'"
+		}
+		if t.prefix {
+			words[len(words)-1] += ":*"
+		}
+		terms[i] = strings.Join(words, " <-> ")
+	}
+	return strings.Join(terms, " & ")
+}
+
+// Highlight returns text HTML-escaped, with the words matching the query
+// wrapped in <mark></mark>
+func (q *Query) Highlight(text string) string {
+	spans := wordSpans(text)
+	return q.mark(text, spans, 0, len(spans))
+}
+
+// Snippet returns an excerpt of text around the first word matching the
+// query, highlighted like by Highlight. Without a match it is the start of
+// text. Cut ends are marked with an ellipsis.
+func (q *Query) Snippet(text string) string {
+	spans := wordSpans(text)
+	first := 0
+	for i, span := range spans {
+		if q.matches(text[span[0]:span[1]]) {
+			first = max(i-snippetWordsBefore, 0)
+			break
+		}
+	}
+	last := min(first+snippetWords, len(spans))
+
+	var b strings.Builder
+	if first > 0 {
+		b.WriteString("…")
+	}
+	b.WriteString(q.mark(text, spans, first, last))
+	if last < len(spans) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// mark escapes and highlights text from the word at first up to the word
+// before last. With all words, it covers the whole text.
+func (q *Query) mark(text string, spans [][2]int, first, last int) string {
+	start, end := 0, len(text)
+	if first > 0 {
+		start = spans[first][0]
+	}
+	if last < len(spans) {
+		end = spans[last-1][1]
+	}
+
+	var b strings.Builder
+	pos := start
+	for _, span := range spans[first:last] {
+		word := text[span[0]:span[1]]
+		if !q.matches(word) {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[pos:span[0]]))
+		b.WriteString("<mark>" + html.EscapeString(word) + "</mark>")
+		pos = span[1]
+	}
+	b.WriteString(html.EscapeString(text[pos:end]))
+	return strings.TrimSpace(b.String())
+}
+
+// matches reports whether word is one of the query's words, or starts with
+// a prefix or a word long enough to be a stem
+func (q *Query) matches(word string) bool {
+	word = strings.ToLower(word)
+	for _, t := range q.terms {
+		for i, w := range t.words {
+			switch {
+			case word == w:
+				return true
+			case !strings.HasPrefix(word, w):
+			case t.prefix && i == len(t.words)-1, len([]rune(w)) >= minStemLength:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// splitWords returns the lowercased words of s
+func splitWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), isSeparator)
+}
+
+// wordSpans returns the byte offsets of the words of s
+func wordSpans(s string) [][2]int {
+	var spans [][2]int
+	start := -1
+	for i, r := range s {
+		switch {
+		case !isSeparator(r) && start < 0:
+			start = i
+		case isSeparator(r) && start >= 0:
+			spans = append(spans, [2]int{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(s)})
+	}
+	return spans
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// document is the tsvector expression of a text column
+func document(column string) string {
+	return "to_tsvector('" + textSearchConfig + "', " + column + ")"
+}
@@ -0,0 +1,153 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text    string
+		tsquery string
+		err     error
+	}{
+		{text: "Product launch", tsquery: "'product' & 'launch'"},
+		{text: `"product launch" next`, tsquery: "'product' <-> 'launch' & 'next'"},
+		{text: `launch*`, tsquery: "'launch':*"},
+		{text: `"big product*"`, tsquery: "'big' <-> 'product':*"},
+		{text: `"unterminated phrase`, tsquery: "'unterminated' <-> 'phrase'"},
+		{text: `e-mail`, tsquery: "'e' <-> 'mail'"},
+		{text: "  ", err: ErrEmptyQuery},
+		{text: "*** !!", err: ErrEmptyQuery},
+		{text: strings.Repeat("word ", maxTerms+1), err: ErrTooManyTerms},
+	}
+	for _, tt := range tests {
+		q, err := Parse(tt.text)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Parse(%q): got error %v, want %v", tt.text, err, tt.err)
+			continue
+		}
+		if err == nil && q.tsquery() != tt.tsquery {
+			t.Errorf("Parse(%q): got tsquery %q, want %q", tt.text, q.tsquery(), tt.tsquery)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	ctx := context.Background()
+
+	owner := client.User.Create().SetID("user-1").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	news := createInfluencer(t, client, owner, "inf-news", "Launch Daily")
+	cats := createInfluencer(t, client, owner, "inf-cats", "Cats")
+	createPost(t, client, news, "post-1", "The product launch is tomorrow")
+	createPost(t, client, news, "post-2", "Launch the new product")
+	createPost(t, client, cats, "post-3", "Launching soon: cat pictures")
+	createPost(t, client, cats, "post-4", "Unrelated")
+
+	tests := []struct {
+		query string
+		// scope restricts the search to the posts of an influencer
+		scope string
+		want  []string
+	}{
+		// A phrase matches its words in order
+		{query: `"product launch"`, want: []string{"post-1"}},
+		// Words match in any order
+		{query: `product launch`, want: []string{"post-1", "post-2"}},
+		// A prefix matches longer words
+		{query: `launch*`, want: []string{"post-1", "post-2", "post-3"}},
+		// Influencer names match too
+		{query: `daily`, want: []string{"post-1", "post-2"}},
+		{query: `launch*`, scope: cats.ID, want: []string{"post-3"}},
+		{query: `daily`, scope: cats.ID, want: nil},
+	}
+	for _, tt := range tests {
+		q, err := Parse(tt.query)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.query, err)
+		}
+
+		scope := post.IDNEQ("")
+		if tt.scope != "" {
+			scope = post.InfluencerID(tt.scope)
+		}
+		ids, err := client.Post.Query().
+			Where(
+				scope,
+				post.Or(
+					predicate.Post(func(s *sql.Selector) {
+						s.Where(q.Match(s, post.FieldContent))
+					}),
+					post.HasInfluencerWith(func(s *sql.Selector) {
+						s.Where(q.Match(s, influencer.FieldName))
+					}),
+				),
+			).
+			Order(ent.Asc(post.FieldID)).
+			IDs(ctx)
+		if err != nil {
+			t.Fatalf("search %q: %v", tt.query, err)
+		}
+		if !slices.Equal(ids, tt.want) {
+			t.Errorf("search %q in %q: got %v, want %v", tt.query, tt.scope, ids, tt.want)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	q, err := Parse(`"product launch" next*`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := q.Highlight("Our <new> Product launches next-week")
+	want := "Our &lt;new&gt; <mark>Product</mark> <mark>launches</mark> <mark>next</mark>-week"
+	if got != want {
+		t.Errorf("Highlight: got %q, want %q", got, want)
+	}
+
+	text := strings.Repeat("lorem ", 20) + "product " + strings.Repeat("ipsum ", 40)
+	got = q.Snippet(text)
+	if !strings.HasPrefix(got, "…lorem") || !strings.HasSuffix(got, "ipsum…") {
+		t.Errorf("Snippet: got %q, want it cut on both ends", got)
+	}
+	if strings.Count(got, "<mark>product</mark>") != 1 {
+		t.Errorf("Snippet: got %q, want the match highlighted", got)
+	}
+}
+
+func createInfluencer(t *testing.T, client *ent.Client, owner *ent.User, id, name string) *ent.Influencer {
+	t.Helper()
+	return client.Influencer.Create().
+		SetID(id).
+		SetName(name).
+		SetPlatform("x").
+		SetOwner(owner).
+		SaveX(context.Background())
+}
+
+func createPost(t *testing.T, client *ent.Client, inf *ent.Influencer, id, content string) {
+	t.Helper()
+	client.Post.Create().
+		SetID(id).
+		SetInfluencer(inf).
+		SetContent(content).
+		SetScheduledTime(time.Now().Add(time.Hour)).
+		ExecX(context.Background())
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/auditevent"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// exportBatchSize is the number of audit events sent per export chunk
+const exportBatchSize = 500
+
+// Audit Log
+func (s *Server) ListAuditEvents(ctx context.Context, req *ocsv1.ListAuditEventsRequest) (*ocsv1.ListAuditEventsResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only admin can read the audit log
+	if !principal.IsAdmin() {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	// Build the query
+	query := s.client.AuditEvent.Query().
+		Where(auditFilter(req.Filter)...)
+
+	// Apply pagination; IDs are time-ordered so this lists oldest first
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, auditEventsByID...)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(page.where).Order(page.order).Limit(page.limit())
+
+	events, err := query.All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit events: %v", err)
+	}
+	events, nextPageToken := page.trim(events)
+
+	protoEvents := make([]*ocsv1.AuditEvent, len(events))
+	for i, e := range events {
+		protoEvents[i] = toProtoAuditEvent(e)
+	}
+
+	return &ocsv1.ListAuditEventsResponse{
+		Events:        protoEvents,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) ExportAuditEvents(req *ocsv1.ExportAuditEventsRequest, stream grpc.ServerStreamingServer[ocsv1.ExportAuditEventsResponse]) error {
+	ctx := stream.Context()
+
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Only admin can read the audit log
+	if !principal.IsAdmin() {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	filter := auditFilter(req.Filter)
+	after := ""
+	for {
+		query := s.client.AuditEvent.Query().
+			Where(filter...).
+			Order(ent.Asc(auditevent.FieldID)).
+			Limit(exportBatchSize)
+		if after != "" {
+			query = query.Where(auditevent.IDGT(after))
+		}
+
+		events, err := query.All(ctx)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to export audit events: %v", err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		// Encode the batch as JSON Lines
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		for _, e := range events {
+			if err := enc.Encode(e); err != nil {
+				return status.Errorf(codes.Internal, "failed to encode audit event: %v", err)
+			}
+		}
+		if err := stream.Send(&ocsv1.ExportAuditEventsResponse{Data: buf.Bytes()}); err != nil {
+			return err
+		}
+
+		if len(events) < exportBatchSize {
+			return nil
+		}
+		after = events[len(events)-1].ID
+	}
+}
+
+// auditFilter translates an AuditEventFilter into ent predicates
+func auditFilter(f *ocsv1.AuditEventFilter) []predicate.AuditEvent {
+	var preds []predicate.AuditEvent
+	if f == nil {
+		return preds
+	}
+	if f.ActorId != "" {
+		preds = append(preds, auditevent.ActorID(f.ActorId))
+	}
+	if f.EntityType != "" {
+		preds = append(preds, auditevent.EntityType(f.EntityType))
+	}
+	if f.EntityId != "" {
+		preds = append(preds, auditevent.EntityID(f.EntityId))
+	}
+	if f.Operation != "" {
+		preds = append(preds, auditevent.Operation(f.Operation))
+	}
+	if f.RequestId != "" {
+		preds = append(preds, auditevent.RequestID(f.RequestId))
+	}
+	if f.StartTime != nil {
+		preds = append(preds, auditevent.CreatedAtGTE(f.StartTime.AsTime()))
+	}
+	if f.EndTime != nil {
+		preds = append(preds, auditevent.CreatedAtLT(f.EndTime.AsTime()))
+	}
+	return preds
+}
@@ -0,0 +1,329 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/membership"
+	"github.com/WuPinYi/SocialForge/internal/ent/organization"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+)
+
+// OrganizationHeader is the request metadata that selects the caller's
+// active organization
+const OrganizationHeader = "x-organization-id"
+
+// Organization roles, in ascending order of privilege
+const (
+	roleViewer = "viewer"
+	roleEditor = "editor"
+	roleAdmin  = "admin"
+	roleOwner  = "owner"
+)
+
+var orgRoles = []string{roleViewer, roleEditor, roleAdmin, roleOwner}
+
+// roleAtLeast reports whether role grants at least the privileges of minRole
+func roleAtLeast(role, minRole string) bool {
+	return slices.Index(orgRoles, role) >= slices.Index(orgRoles, minRole)
+}
+
+// Influencer access levels, in ascending order of privilege. They are
+// granted directly with an AccessGrant or implied by an organization role.
+const (
+	levelViewer    = "viewer"
+	levelDrafter   = "drafter"
+	levelPublisher = "publisher"
+	levelManager   = "manager"
+)
+
+var accessLevels = []string{levelViewer, levelDrafter, levelPublisher, levelManager}
+
+// levelAtLeast reports whether level grants at least the privileges of
+// minLevel. The empty level grants nothing.
+func levelAtLeast(level, minLevel string) bool {
+	return level != "" && slices.Index(accessLevels, level) >= slices.Index(accessLevels, minLevel)
+}
+
+// maxLevel returns the more privileged of two access levels
+func maxLevel(a, b string) string {
+	if levelAtLeast(a, b) || b == "" {
+		return a
+	}
+	return b
+}
+
+// roleLevels maps organization roles to the influencer access they imply
+var roleLevels = map[string]string{
+	roleViewer: levelViewer,
+	roleEditor: levelPublisher,
+	roleAdmin:  levelManager,
+	roleOwner:  levelManager,
+}
+
+// orgAccess is the caller's role within an organization
+type orgAccess struct {
+	OrganizationID string
+	Role           string
+}
+
+// requestedOrganization returns the organization ID from the request
+// metadata, or "" if none was selected
+func requestedOrganization(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(OrganizationHeader); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// activeOrganization resolves the organization selected by the request
+// metadata, falling back to the caller's personal organization, and checks
+// that the caller holds at least the minRole role in it.
+func (s *Server) activeOrganization(ctx context.Context, principal *auth.Principal, minRole string) (*orgAccess, error) {
+	orgID := requestedOrganization(ctx)
+	if orgID == "" {
+		m, err := s.personalMembership(ctx, principal)
+		if err != nil {
+			return nil, err
+		}
+		return &orgAccess{OrganizationID: m.OrganizationID, Role: m.Role}, nil
+	}
+
+	access, err := s.organizationAccess(ctx, principal, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if access == nil {
+		return nil, status.Error(codes.PermissionDenied, "not a member of the organization")
+	}
+	if !roleAtLeast(access.Role, minRole) {
+		return nil, status.Errorf(codes.PermissionDenied, "requires the %s role in the organization", minRole)
+	}
+	return access, nil
+}
+
+// organizationAccess returns the caller's role in orgID, or nil if the
+// caller is not a member. Admin is treated as an owner of every organization.
+func (s *Server) organizationAccess(ctx context.Context, principal *auth.Principal, orgID string) (*orgAccess, error) {
+	m, err := s.client.Membership.Query().
+		Where(
+			membership.OrganizationID(orgID),
+			membership.UserID(principal.UserID),
+		).
+		Only(ctx)
+	if err == nil {
+		return &orgAccess{OrganizationID: orgID, Role: m.Role}, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.Internal, "failed to get membership: %v", err)
+	}
+
+	if !principal.IsAdmin() {
+		return nil, nil
+	}
+	exists, err := s.client.Organization.Query().Where(organization.ID(orgID)).Exist(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+	return &orgAccess{OrganizationID: orgID, Role: roleOwner}, nil
+}
+
+// personalMembership returns the caller's membership in their personal
+// organization, creating the organization on first use
+func (s *Server) personalMembership(ctx context.Context, principal *auth.Principal) (*ent.Membership, error) {
+	m, err := s.getPersonalMembership(ctx, principal)
+	if err == nil {
+		return m, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.Internal, "failed to get personal organization: %v", err)
+	}
+
+	m, err = s.createPersonalOrganization(ctx, principal)
+	if err != nil {
+		// A concurrent request created it first
+		if ent.IsConstraintError(err) {
+			if m, err := s.getPersonalMembership(ctx, principal); err == nil {
+				return m, nil
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create personal organization: %v", err)
+	}
+	return m, nil
+}
+
+func (s *Server) getPersonalMembership(ctx context.Context, principal *auth.Principal) (*ent.Membership, error) {
+	return s.client.Membership.Query().
+		Where(
+			membership.UserID(principal.UserID),
+			membership.HasOrganizationWith(organization.PersonalUserID(principal.UserID)),
+		).
+		Only(ctx)
+}
+
+// createPersonalOrganization creates the caller's personal organization.
+// The organization's personal_user_id is unique, so only one of concurrent
+// calls succeeds; the others get a constraint error.
+func (s *Server) createPersonalOrganization(ctx context.Context, principal *auth.Principal) (*ent.Membership, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	org, err := tx.Organization.Create().
+		SetID(uuid.New().String()).
+		SetName("Personal").
+		SetPersonal(true).
+		SetPersonalUserID(principal.UserID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	m, err := tx.Membership.Create().
+		SetID(uuid.New().String()).
+		SetOrganization(org).
+		SetUserID(principal.UserID).
+		SetRole(roleOwner).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create membership: %v", err)
+	}
+
+	// Adopt influencers created before organizations existed
+	err = tx.Influencer.Update().
+		Where(
+			influencer.OrganizationIDIsNil(),
+			influencer.HasOwnerWith(user.ID(principal.UserID)),
+		).
+		SetOrganization(org).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to adopt influencers: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	return m.Unwrap(), nil
+}
+
+// authorizeInfluencer loads an influencer with its owner and checks that the
+// caller holds at least minLevel access to it. It returns the caller's
+// effective access level.
+func (s *Server) authorizeInfluencer(ctx context.Context, principal *auth.Principal, id string, minLevel string) (*ent.Influencer, string, error) {
+	inf, err := s.client.Influencer.Query().
+		Where(influencer.ID(id)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, "", status.Error(codes.NotFound, "influencer not found")
+		}
+		return nil, "", status.Errorf(codes.Internal, "failed to get influencer: %v", err)
+	}
+
+	level, err := s.influencerAccess(ctx, principal, inf)
+	if err != nil {
+		return nil, "", err
+	}
+	if !levelAtLeast(level, minLevel) {
+		return nil, "", status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return inf, level, nil
+}
+
+// influencerAccess returns the caller's effective access level for an
+// influencer with its owner loaded: the higher of the level implied by the
+// caller's organization role and any unexpired access grant. It returns ""
+// if the caller has no access.
+func (s *Server) influencerAccess(ctx context.Context, principal *auth.Principal, inf *ent.Influencer) (string, error) {
+	if principal.IsAdmin() {
+		return levelManager, nil
+	}
+
+	level := ""
+	if inf.OrganizationID == "" {
+		// Influencers without an organization belong to their owner alone
+		if inf.Edges.Owner != nil && inf.Edges.Owner.ID == principal.UserID {
+			level = levelManager
+		}
+	} else {
+		access, err := s.organizationAccess(ctx, principal, inf.OrganizationID)
+		if err != nil {
+			return "", err
+		}
+		if access != nil {
+			level = roleLevels[access.Role]
+		}
+	}
+	if level == levelManager {
+		return level, nil
+	}
+
+	grant, err := s.activeGrant(ctx, principal, inf.ID)
+	if err != nil {
+		return "", err
+	}
+	if grant != nil {
+		level = maxLevel(level, grant.Level)
+	}
+	return level, nil
+}
+
+// activeGrant returns the caller's unexpired access grant for an influencer,
+// or nil if there is none
+func (s *Server) activeGrant(ctx context.Context, principal *auth.Principal, influencerID string) (*ent.AccessGrant, error) {
+	grant, err := s.client.AccessGrant.Query().
+		Where(
+			accessgrant.InfluencerID(influencerID),
+			accessgrant.UserID(principal.UserID),
+			accessgrant.Or(
+				accessgrant.ExpiresAtIsNil(),
+				accessgrant.ExpiresAtGT(time.Now()),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get access grant: %v", err)
+	}
+	return grant, nil
+}
+
+// visibleInfluencers selects the influencers of the caller's active
+// organization and those shared with the caller by an unexpired grant
+func visibleInfluencers(principal *auth.Principal, org *orgAccess) predicate.Influencer {
+	return influencer.Or(
+		influencer.OrganizationID(org.OrganizationID),
+		influencer.HasAccessGrantsWith(
+			accessgrant.UserID(principal.UserID),
+			accessgrant.Or(
+				accessgrant.ExpiresAtIsNil(),
+				accessgrant.ExpiresAtGT(time.Now()),
+			),
+		),
+	)
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	"github.com/WuPinYi/SocialForge/internal/ent/organization"
+)
+
+func TestPersonalMembershipConcurrentCreate(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	ctx := context.Background()
+	s := NewServer(client)
+
+	alice := client.User.Create().SetID("user-alice").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	principal := &auth.Principal{Kind: auth.PrincipalUser, Subject: alice.Auth0ID, UserID: alice.ID}
+
+	// Another request creates the personal organization right after this
+	// one found none
+	var other *ent.Membership
+	created := false
+	client.Membership.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			v, err := next.Query(ctx, q)
+			if !created {
+				created = true
+				var cerr error
+				if other, cerr = s.createPersonalOrganization(ctx, principal); cerr != nil {
+					t.Fatalf("concurrent create: %v", cerr)
+				}
+			}
+			return v, err
+		})
+	}))
+
+	m, err := s.personalMembership(ctx, principal)
+	if err != nil {
+		t.Fatalf("personalMembership: %v", err)
+	}
+	if m.ID != other.ID {
+		t.Errorf("membership = %s, want the concurrently created %s", m.ID, other.ID)
+	}
+	if n := client.Organization.Query().Where(organization.Personal(true)).CountX(ctx); n != 1 {
+		t.Errorf("got %d personal organizations, want 1", n)
+	}
+}
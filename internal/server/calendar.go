@@ -0,0 +1,207 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// Longest time ranges a calendar can cover
+const (
+	maxCalendarRange       = 366 * 24 * time.Hour
+	maxHourlyCalendarRange = 31 * 24 * time.Hour
+)
+
+// Calendar granularities
+const (
+	granularityDay  = "day"
+	granularityHour = "hour"
+)
+
+// Calendar
+func (s *Server) ListCalendar(ctx context.Context, req *ocsv1.ListCalendarRequest) (*ocsv1.ListCalendarResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the time range and buckets
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	}
+	start, end := req.StartTime.AsTime(), req.EndTime.AsTime()
+	if !end.After(start) {
+		return nil, status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+	switch req.Granularity {
+	case "", granularityDay:
+		if end.Sub(start) > maxCalendarRange {
+			return nil, status.Error(codes.InvalidArgument, "the time range can span at most 366 days")
+		}
+	case granularityHour:
+		if end.Sub(start) > maxHourlyCalendarRange {
+			return nil, status.Error(codes.InvalidArgument, "hourly counts can span at most 31 days")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown granularity %q; use \"day\" or \"hour\"", req.Granularity)
+	}
+	loc := time.UTC
+	if req.TimeZone != "" {
+		// Local is the server's zone, which the database does not know
+		if loc, err = time.LoadLocation(req.TimeZone); err != nil || req.TimeZone == "Local" {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown time_zone %q", req.TimeZone)
+		}
+	}
+
+	// The calendar shows the influencers of the active organization and
+	// those shared with the caller
+	org, err := s.activeOrganization(ctx, principal, roleViewer)
+	if err != nil {
+		return nil, err
+	}
+	visible := visibleInfluencers(principal, org)
+	if len(req.InfluencerIds) > 0 {
+		visible = influencer.And(visible, influencer.IDIn(req.InfluencerIds...))
+	}
+
+	// Build the query
+	filter, err := postListing.filter(req)
+	if err != nil {
+		return nil, err
+	}
+	query := s.client.Post.Query().
+		Where(
+			post.HasInfluencerWith(visible, influencer.DeletedAtIsNil()),
+			post.ScheduledTimeGTE(start),
+			post.ScheduledTimeLT(end),
+		).
+		Where(filter)
+
+	if req.Granularity != "" {
+		buckets, err := s.countCalendar(ctx, query, req.Granularity, loc)
+		if err != nil {
+			return nil, err
+		}
+		return &ocsv1.ListCalendarResponse{Buckets: buckets}, nil
+	}
+
+	// Apply pagination, in order of scheduled time
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, postsBySchedule...)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(page.where).Order(page.order).Limit(page.limit())
+
+	posts, err := query.All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list posts: %v", err)
+	}
+	posts, nextPageToken := page.trim(posts)
+
+	protoPosts := make([]*ocsv1.Post, len(posts))
+	for i, p := range posts {
+		protoPosts[i] = toProtoPost(p)
+	}
+
+	return &ocsv1.ListCalendarResponse{
+		Posts:         protoPosts,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// calendarKey identifies a calendar bucket
+type calendarKey struct {
+	start        time.Time
+	influencerID string
+	status       string
+}
+
+// calendarRow is one group of posts counted by countCalendar
+type calendarRow struct {
+	InfluencerID string    `json:"influencer_id"`
+	Status       string    `json:"status"`
+	Bucket       time.Time `json:"bucket"`
+	Count        int32     `json:"count"`
+}
+
+// countCalendar counts the posts matched by query per influencer, status and
+// day or hour in loc. On Postgres the posts are grouped by the start of
+// their bucket, in loc's wall-clock time. Elsewhere, i.e. in tests on
+// SQLite, which has no time zones, they are grouped by their scheduled time
+// and the groups are added up into buckets here.
+func (s *Server) countCalendar(ctx context.Context, query *ent.PostQuery, granularity string, loc *time.Location) ([]*ocsv1.CalendarBucket, error) {
+	var wallClock bool
+	var rows []calendarRow
+	err := query.
+		Select(post.FieldInfluencerID, post.FieldStatus).
+		Aggregate(func(sel *sql.Selector) string {
+			column := sel.C(post.FieldScheduledTime)
+			if sel.Dialect() == dialect.Postgres {
+				wallClock = true
+				sel.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("date_trunc(").Arg(granularity).
+						WriteString(", " + column + " AT TIME ZONE ").Arg(loc.String()).
+						WriteString(")")
+				}), "bucket")
+			} else {
+				sel.AppendSelectAs(column, "bucket")
+			}
+			sel.GroupBy(sel.C(post.FieldInfluencerID), sel.C(post.FieldStatus), "bucket")
+			return sql.As(sql.Count("*"), "count")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count posts: %v", err)
+	}
+
+	counts := make(map[calendarKey]int32)
+	for _, r := range rows {
+		// A wall-clock time is scanned as UTC
+		t := r.Bucket.In(loc)
+		if wallClock {
+			t = r.Bucket.UTC()
+		}
+		start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		if granularity == granularityHour {
+			start = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+		}
+		counts[calendarKey{start: start, influencerID: r.InfluencerID, status: r.Status}] += r.Count
+	}
+
+	keys := make([]calendarKey, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b calendarKey) int {
+		return cmp.Or(
+			a.start.Compare(b.start),
+			cmp.Compare(a.influencerID, b.influencerID),
+			cmp.Compare(a.status, b.status),
+		)
+	})
+
+	buckets := make([]*ocsv1.CalendarBucket, len(keys))
+	for i, k := range keys {
+		buckets[i] = &ocsv1.CalendarBucket{
+			StartTime:    timestamppb.New(k.start),
+			InfluencerId: k.influencerID,
+			Status:       k.status,
+			Count:        counts[k],
+		}
+	}
+	return buckets, nil
+}
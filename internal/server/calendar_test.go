@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+func TestListCalendarCounts(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	ctx := context.Background()
+	s := NewServer(client)
+
+	alice := client.User.Create().SetID("user-alice").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	aliceCtx := auth.NewContext(ctx, &auth.Principal{Kind: auth.PrincipalUser, Subject: alice.Auth0ID, UserID: alice.ID})
+	inf := client.Influencer.Create().SetID("inf-1").SetName("Alice").SetPlatform("x").SetOwner(alice).SaveX(ctx)
+
+	// Berlin is two hours ahead of UTC in October
+	posts := []struct {
+		at     string
+		status string
+	}{
+		{at: "2026-10-18T21:30:00Z", status: "scheduled"},
+		{at: "2026-10-18T22:30:00Z", status: "scheduled"},
+		{at: "2026-10-18T22:45:00Z", status: "scheduled"},
+		{at: "2026-10-19T10:00:00Z", status: "paused"},
+	}
+	for i, p := range posts {
+		at, _ := time.Parse(time.RFC3339, p.at)
+		client.Post.Create().
+			SetID(fmt.Sprintf("post-%d", i)).
+			SetInfluencer(inf).
+			SetContent("Hello").
+			SetScheduledTime(at).
+			SetStatus(p.status).
+			ExecX(ctx)
+	}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("no time zone data: %v", err)
+	}
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, berlin)
+	req := &ocsv1.ListCalendarRequest{
+		StartTime:   timestamppb.New(start),
+		EndTime:     timestamppb.New(start.AddDate(0, 0, 2)),
+		Granularity: granularityDay,
+		TimeZone:    "Europe/Berlin",
+	}
+	tests := []struct {
+		granularity string
+		want        []string
+	}{
+		{granularity: granularityDay, want: []string{
+			"2026-10-18T00:00:00+02:00 scheduled 1",
+			"2026-10-19T00:00:00+02:00 paused 1",
+			"2026-10-19T00:00:00+02:00 scheduled 2",
+		}},
+		{granularity: granularityHour, want: []string{
+			"2026-10-18T23:00:00+02:00 scheduled 1",
+			"2026-10-19T00:00:00+02:00 scheduled 2",
+			"2026-10-19T12:00:00+02:00 paused 1",
+		}},
+	}
+	for _, tt := range tests {
+		req.Granularity = tt.granularity
+		resp, err := s.ListCalendar(aliceCtx, req)
+		if err != nil {
+			t.Fatalf("ListCalendar(%s): %v", tt.granularity, err)
+		}
+		var got []string
+		for _, b := range resp.Buckets {
+			if b.InfluencerId != inf.ID {
+				t.Errorf("bucket influencer = %q, want %q", b.InfluencerId, inf.ID)
+			}
+			got = append(got, fmt.Sprintf("%s %s %d", b.StartTime.AsTime().In(berlin).Format(time.RFC3339), b.Status, b.Count))
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%s buckets = %q, want %q", tt.granularity, got, tt.want)
+		}
+	}
+
+	req.TimeZone = "Local"
+	if _, err := s.ListCalendar(aliceCtx, req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("time_zone Local: %v, want InvalidArgument", err)
+	}
+}
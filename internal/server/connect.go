@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/connect"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// Account Connect
+//
+// ConnectInfluencer only starts the flow. The influencer is created or
+// updated, and its tokens stored, by the HTTP callback once the user has
+// authorized the account on the platform, in the browser that called
+// ConnectInfluencer through the gateway. Native apps that give their own
+// redirect URL complete the flow with CompleteConnectInfluencer instead.
+func (s *Server) ConnectInfluencer(ctx context.Context, req *ocsv1.ConnectInfluencerRequest) (*ocsv1.ConnectInfluencerResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.connect == nil {
+		return nil, status.Error(codes.FailedPrecondition, "account connect is not configured")
+	}
+
+	start := connect.StartRequest{
+		Platform:     req.Platform,
+		UserID:       principal.UserID,
+		InfluencerID: req.InfluencerId,
+		Name:         req.Name,
+		RedirectURL:  req.RedirectUrl,
+	}
+	if req.InfluencerId != "" {
+		// Reconnecting requires the same access as managing credentials
+		influencer, _, err := s.authorizeInfluencer(ctx, principal, req.InfluencerId, levelManager)
+		if err != nil {
+			return nil, err
+		}
+		if req.Platform != "" && req.Platform != influencer.Platform {
+			return nil, status.Errorf(codes.InvalidArgument, "influencer is on %s, not %s", influencer.Platform, req.Platform)
+		}
+		start.Platform = influencer.Platform
+		start.OrganizationID = influencer.OrganizationID
+	} else {
+		if req.Platform == "" {
+			return nil, status.Error(codes.InvalidArgument, "platform is required")
+		}
+		// New influencers are created in the caller's active organization
+		org, err := s.activeOrganization(ctx, principal, roleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		start.OrganizationID = org.OrganizationID
+	}
+
+	authorization, err := s.connect.Start(ctx, start)
+	if err != nil {
+		if errors.Is(err, connect.ErrUnknownPlatform) {
+			return nil, status.Errorf(codes.InvalidArgument, "platform %q can't be connected", start.Platform)
+		}
+		if errors.Is(err, connect.ErrRedirectURL) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to start account connect: %v", err)
+	}
+
+	// The callback only accepts the browser that got the cookie, so that
+	// nobody else can be tricked into completing the flow
+	if authorization.Cookie != nil {
+		if err := grpc.SetHeader(ctx, metadata.Pairs(connect.SetCookieHeader, authorization.Cookie.String())); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set session cookie: %v", err)
+		}
+	}
+
+	return &ocsv1.ConnectInfluencerResponse{
+		AuthorizationUrl: authorization.URL,
+		ExpiresAt:        timestamppb.New(authorization.ExpiresAt),
+	}, nil
+}
+
+func (s *Server) CompleteConnectInfluencer(ctx context.Context, req *ocsv1.CompleteConnectInfluencerRequest) (*ocsv1.CompleteConnectInfluencerResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.connect == nil {
+		return nil, status.Error(codes.FailedPrecondition, "account connect is not configured")
+	}
+
+	// The session must have been started by the caller with a redirect URL;
+	// anything else is reported like an unknown state
+	influencer, err := s.connect.CompleteApp(ctx, principal.UserID, req.State, req.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, connect.ErrInvalidState):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, connect.ErrAccountMismatch), errors.Is(err, connect.ErrAccountConnected):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, connect.ErrProvider):
+			return nil, status.Error(codes.Unavailable, connect.ErrProvider.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to connect account: %v", err)
+	}
+
+	ownerID, err := influencer.QueryOwner().OnlyID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get influencer owner: %v", err)
+	}
+
+	return &ocsv1.CompleteConnectInfluencerResponse{
+		Influencer: toProtoInfluencer(influencer, ownerID),
+	}, nil
+}
@@ -0,0 +1,192 @@
+package server
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/WuPinYi/SocialForge/internal/connect"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+func toProtoUser(u *ent.User) *ocsv1.User {
+	return &ocsv1.User{
+		Id:        u.ID,
+		Email:     u.Email,
+		Name:      u.Name,
+		Role:      u.Role,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+		UpdatedAt: timestamppb.New(u.UpdatedAt),
+		Etag:      etag(u.UpdatedAt),
+	}
+}
+
+func toProtoInfluencer(inf *ent.Influencer, ownerID string) *ocsv1.Influencer {
+	pb := &ocsv1.Influencer{
+		Id:             inf.ID,
+		Name:           inf.Name,
+		Platform:       inf.Platform,
+		AccountId:      inf.AccountID,
+		Status:         inf.Status,
+		OwnerId:        ownerID,
+		OrganizationId: inf.OrganizationID,
+		CreatedAt:      timestamppb.New(inf.CreatedAt),
+		UpdatedAt:      timestamppb.New(inf.UpdatedAt),
+		Etag:           etag(inf.UpdatedAt),
+	}
+	if inf.AccountVerifiedAt != nil {
+		pb.AccountVerifiedAt = timestamppb.New(*inf.AccountVerifiedAt)
+	}
+	if c := inf.Edges.Credential; c != nil && c.ExpiresAt != nil {
+		pb.TokenExpiresAt = timestamppb.New(*c.ExpiresAt)
+	}
+	if inf.DeletedAt != nil {
+		pb.DeletedAt = timestamppb.New(*inf.DeletedAt)
+	}
+	pb.TokenHealth = tokenHealth(inf)
+	pb.DisconnectReason = inf.DisconnectReason
+	return pb
+}
+
+// tokenHealth reports the state of the influencer's platform token from its
+// credential edge, which has to be loaded with withTokenExpiry
+func tokenHealth(inf *ent.Influencer) string {
+	c := inf.Edges.Credential
+	switch {
+	case inf.Status == "disconnected":
+		return "disconnected"
+	case c == nil:
+		return "none"
+	case c.ExpiresAt == nil:
+		return "healthy"
+	case time.Now().After(*c.ExpiresAt):
+		return "expired"
+	case time.Now().Add(connect.RefreshWindow).After(*c.ExpiresAt):
+		return "expiring"
+	default:
+		return "healthy"
+	}
+}
+
+// withTokenExpiry loads only what tokenHealth needs from the credential,
+// leaving the encrypted secret in the database
+func withTokenExpiry(q *ent.CredentialQuery) {
+	q.Select(credential.FieldInfluencerID, credential.FieldExpiresAt)
+}
+
+func toProtoPost(p *ent.Post) *ocsv1.Post {
+	pb := &ocsv1.Post{
+		Id:            p.ID,
+		InfluencerId:  p.InfluencerID,
+		Content:       p.Content,
+		ScheduledTime: timestamppb.New(p.ScheduledTime),
+		Status:        p.Status,
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		UpdatedAt:     timestamppb.New(p.UpdatedAt),
+		Etag:          etag(p.UpdatedAt),
+	}
+	if p.DeletedAt != nil {
+		pb.DeletedAt = timestamppb.New(*p.DeletedAt)
+	}
+	return pb
+}
+
+func toProtoOrganization(o *ent.Organization) *ocsv1.Organization {
+	return &ocsv1.Organization{
+		Id:        o.ID,
+		Name:      o.Name,
+		Personal:  o.Personal,
+		CreatedAt: timestamppb.New(o.CreatedAt),
+		UpdatedAt: timestamppb.New(o.UpdatedAt),
+	}
+}
+
+func toProtoMembership(m *ent.Membership) *ocsv1.Membership {
+	return &ocsv1.Membership{
+		Id:             m.ID,
+		OrganizationId: m.OrganizationID,
+		UserId:         m.UserID,
+		Role:           m.Role,
+		CreatedAt:      timestamppb.New(m.CreatedAt),
+		UpdatedAt:      timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func toProtoServiceAccount(sa *ent.ServiceAccount, ownerID string) *ocsv1.ServiceAccount {
+	pb := &ocsv1.ServiceAccount{
+		Id:        sa.ID,
+		Name:      sa.Name,
+		OwnerId:   ownerID,
+		Scopes:    sa.Scopes,
+		KeyPrefix: sa.KeyPrefix,
+		CreatedAt: timestamppb.New(sa.CreatedAt),
+		UpdatedAt: timestamppb.New(sa.UpdatedAt),
+	}
+	if sa.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*sa.ExpiresAt)
+	}
+	if sa.LastUsedAt != nil {
+		pb.LastUsedAt = timestamppb.New(*sa.LastUsedAt)
+	}
+	if sa.RevokedAt != nil {
+		pb.RevokedAt = timestamppb.New(*sa.RevokedAt)
+	}
+	if sa.ClientCertIdentity != nil {
+		pb.ClientCertIdentity = *sa.ClientCertIdentity
+	}
+	return pb
+}
+
+func toProtoAccessGrant(g *ent.AccessGrant) *ocsv1.AccessGrant {
+	pb := &ocsv1.AccessGrant{
+		Id:           g.ID,
+		InfluencerId: g.InfluencerID,
+		UserId:       g.UserID,
+		Level:        g.Level,
+		GrantedBy:    g.GrantedBy,
+		CreatedAt:    timestamppb.New(g.CreatedAt),
+		UpdatedAt:    timestamppb.New(g.UpdatedAt),
+	}
+	if g.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*g.ExpiresAt)
+	}
+	return pb
+}
+
+func toProtoAuditEvent(e *ent.AuditEvent) *ocsv1.AuditEvent {
+	pb := &ocsv1.AuditEvent{
+		Id:               e.ID,
+		ActorKind:        e.ActorKind,
+		ActorId:          e.ActorID,
+		ActorSubject:     e.ActorSubject,
+		ServiceAccountId: e.ServiceAccountID,
+		Operation:        e.Operation,
+		EntityType:       e.EntityType,
+		EntityId:         e.EntityID,
+		RequestId:        e.RequestID,
+		ClientIp:         e.ClientIP,
+		CreatedAt:        timestamppb.New(e.CreatedAt),
+	}
+	// Changes were decoded from JSON, so they always convert cleanly
+	if changes, err := structpb.NewStruct(e.Changes); err == nil {
+		pb.Changes = changes
+	}
+	return pb
+}
+
+func toProtoNotification(n *ent.Notification) *ocsv1.Notification {
+	pb := &ocsv1.Notification{
+		Id:           n.ID,
+		Kind:         n.Kind,
+		InfluencerId: n.InfluencerID,
+		Message:      n.Message,
+		CreatedAt:    timestamppb.New(n.CreatedAt),
+	}
+	if n.ReadAt != nil {
+		pb.ReadAt = timestamppb.New(*n.ReadAt)
+	}
+	return pb
+}
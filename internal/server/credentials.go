@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/connect"
+	"github.com/WuPinYi/SocialForge/internal/vault"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// Influencer Credentials
+//
+// Credentials can only be written or deleted here. Decryption happens in
+// the workers when publishing or refreshing tokens and nowhere else.
+func (s *Server) SetInfluencerCredentials(ctx context.Context, req *ocsv1.SetInfluencerCredentialsRequest) (*ocsv1.SetInfluencerCredentialsResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.credentials == nil {
+		return nil, status.Error(codes.FailedPrecondition, "credential vault is not configured")
+	}
+	if req.AccessToken == "" && req.AppSecret == "" {
+		return nil, status.Error(codes.InvalidArgument, "access_token or app_secret is required")
+	}
+
+	// Check if the user has permission to manage this influencer
+	influencer, _, err := s.authorizeInfluencer(ctx, principal, req.InfluencerId, levelManager)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &vault.Secret{
+		AccessToken:  req.AccessToken,
+		RefreshToken: req.RefreshToken,
+		TokenType:    req.TokenType,
+		AppSecret:    req.AppSecret,
+	}
+	if req.ExpiresAt != nil {
+		secret.Expiry = req.ExpiresAt.AsTime().UTC().Truncate(time.Second)
+	}
+
+	if err := s.credentials.Put(ctx, req.InfluencerId, secret); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store credentials: %v", err)
+	}
+
+	// New credentials reconnect a disconnected influencer, but only once its
+	// account has been proven through the connect flow. Until then it stays
+	// disconnected, so nothing is published to an account nobody verified.
+	if influencer.Status == "disconnected" && influencer.AccountVerifiedAt != nil && influencer.AccountID != "" {
+		if err := s.reconnectInfluencer(ctx, influencer.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ocsv1.SetInfluencerCredentialsResponse{}, nil
+}
+
+func (s *Server) DeleteInfluencerCredentials(ctx context.Context, req *ocsv1.DeleteInfluencerCredentialsRequest) (*ocsv1.DeleteInfluencerCredentialsResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.credentials == nil {
+		return nil, status.Error(codes.FailedPrecondition, "credential vault is not configured")
+	}
+
+	// Check if the user has permission to manage this influencer
+	if _, _, err := s.authorizeInfluencer(ctx, principal, req.InfluencerId, levelManager); err != nil {
+		return nil, err
+	}
+
+	if err := s.credentials.Delete(ctx, req.InfluencerId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete credentials: %v", err)
+	}
+
+	return &ocsv1.DeleteInfluencerCredentialsResponse{}, nil
+}
+
+// reconnectInfluencer marks a disconnected influencer active again and
+// resumes the posts that were paused when it was disconnected
+func (s *Server) reconnectInfluencer(ctx context.Context, id string) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.Influencer.UpdateOneID(id).
+		SetStatus("active").
+		ClearDisconnectReason().
+		Exec(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update influencer: %v", err)
+	}
+	if err := connect.ResumePosts(ctx, tx, id, time.Now()); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "failed to reconnect influencer: %v", err)
+	}
+	return nil
+}
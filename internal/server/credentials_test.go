@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	"github.com/WuPinYi/SocialForge/internal/vault"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+func TestSetInfluencerCredentialsReconnect(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	ctx := context.Background()
+
+	keyring, err := vault.ParseKeyring("1:"+base64.StdEncoding.EncodeToString(make([]byte, 32)), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewServer(client, WithCredentialStore(vault.NewStore(client, keyring)))
+
+	alice := client.User.Create().SetID("user-alice").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	aliceCtx := auth.NewContext(ctx, &auth.Principal{Kind: auth.PrincipalUser, Subject: alice.Auth0ID, UserID: alice.ID})
+
+	verified := client.Influencer.Create().
+		SetID("inf-verified").
+		SetName("Verified").
+		SetPlatform("x").
+		SetAccountID("acct-1").
+		SetAccountVerifiedAt(time.Now()).
+		SetStatus("disconnected").
+		SetOwner(alice).
+		SaveX(ctx)
+	unverified := client.Influencer.Create().
+		SetID("inf-unverified").
+		SetName("Unverified").
+		SetPlatform("x").
+		SetAccountID("acct-2").
+		SetStatus("disconnected").
+		SetOwner(alice).
+		SaveX(ctx)
+	noAccount := client.Influencer.Create().
+		SetID("inf-no-account").
+		SetName("No account").
+		SetPlatform("x").
+		SetAccountVerifiedAt(time.Now()).
+		SetStatus("disconnected").
+		SetOwner(alice).
+		SaveX(ctx)
+
+	client.Post.Create().
+		SetID("post-paused").
+		SetInfluencer(verified).
+		SetContent("Paused").
+		SetScheduledTime(time.Now().Add(time.Hour)).
+		SetStatus("paused").
+		ExecX(ctx)
+
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{id: verified.ID, want: "active"},
+		{id: unverified.ID, want: "disconnected"},
+		{id: noAccount.ID, want: "disconnected"},
+	}
+	for _, tt := range tests {
+		_, err := s.SetInfluencerCredentials(aliceCtx, &ocsv1.SetInfluencerCredentialsRequest{
+			InfluencerId: tt.id,
+			AccessToken:  "token",
+		})
+		if err != nil {
+			t.Fatalf("SetInfluencerCredentials(%s): %v", tt.id, err)
+		}
+		if got := client.Influencer.GetX(ctx, tt.id).Status; got != tt.want {
+			t.Errorf("%s: status = %q, want %q", tt.id, got, tt.want)
+		}
+	}
+
+	if got := client.Post.GetX(ctx, "post-paused").Status; got != "scheduled" {
+		t.Errorf("paused post status = %q, want scheduled", got)
+	}
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// Influencer Access Grants
+func (s *Server) GrantInfluencerAccess(ctx context.Context, req *ocsv1.GrantInfluencerAccessRequest) (*ocsv1.GrantInfluencerAccessResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(accessLevels, req.Level) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown access level %q", req.Level)
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.AsTime().After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+	}
+
+	// Only managers can delegate access to an influencer
+	if _, _, err := s.authorizeInfluencer(ctx, principal, req.InfluencerId, levelManager); err != nil {
+		return nil, err
+	}
+
+	// Get the user receiving access
+	exists, err := s.client.User.Query().Where(user.ID(req.UserId)).Exist(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	// Granting again replaces the level and expiry of an existing grant
+	grant, err := s.client.AccessGrant.Query().
+		Where(
+			accessgrant.InfluencerID(req.InfluencerId),
+			accessgrant.UserID(req.UserId),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		update := grant.Update().
+			SetLevel(req.Level).
+			SetGrantedBy(principal.UserID)
+		if req.ExpiresAt != nil {
+			update.SetExpiresAt(req.ExpiresAt.AsTime())
+		} else {
+			update.ClearExpiresAt()
+		}
+		grant, err = update.Save(ctx)
+	case ent.IsNotFound(err):
+		create := s.client.AccessGrant.Create().
+			SetID(uuid.New().String()).
+			SetInfluencerID(req.InfluencerId).
+			SetUserID(req.UserId).
+			SetLevel(req.Level).
+			SetGrantedBy(principal.UserID)
+		if req.ExpiresAt != nil {
+			create.SetExpiresAt(req.ExpiresAt.AsTime())
+		}
+		grant, err = create.Save(ctx)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to grant access: %v", err)
+	}
+
+	return &ocsv1.GrantInfluencerAccessResponse{
+		Grant: toProtoAccessGrant(grant),
+	}, nil
+}
+
+func (s *Server) RevokeInfluencerAccess(ctx context.Context, req *ocsv1.RevokeInfluencerAccessRequest) (*ocsv1.RevokeInfluencerAccessResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Managers can revoke any grant; everyone can give up their own
+	if req.UserId != principal.UserID {
+		if _, _, err := s.authorizeInfluencer(ctx, principal, req.InfluencerId, levelManager); err != nil {
+			return nil, err
+		}
+	}
+
+	n, err := s.client.AccessGrant.Delete().
+		Where(
+			accessgrant.InfluencerID(req.InfluencerId),
+			accessgrant.UserID(req.UserId),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access: %v", err)
+	}
+	if n == 0 {
+		return nil, status.Error(codes.NotFound, "access grant not found")
+	}
+
+	return &ocsv1.RevokeInfluencerAccessResponse{}, nil
+}
+
+func (s *Server) ListInfluencerAccessGrants(ctx context.Context, req *ocsv1.ListInfluencerAccessGrantsRequest) (*ocsv1.ListInfluencerAccessGrantsResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the user has permission to manage access to this influencer
+	if _, _, err := s.authorizeInfluencer(ctx, principal, req.InfluencerId, levelManager); err != nil {
+		return nil, err
+	}
+
+	// Build the query
+	query := s.client.AccessGrant.Query().Where(accessgrant.InfluencerID(req.InfluencerId))
+
+	// Apply pagination, oldest first
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, grantsByCreation...)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(page.where).Order(page.order).Limit(page.limit())
+
+	grants, err := query.All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list access grants: %v", err)
+	}
+	grants, nextPageToken := page.trim(grants)
+
+	protoGrants := make([]*ocsv1.AccessGrant, len(grants))
+	for i, g := range grants {
+		protoGrants[i] = toProtoAccessGrant(g)
+	}
+
+	return &ocsv1.ListInfluencerAccessGrantsResponse{
+		Grants:        protoGrants,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,232 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"go.einride.tech/aip/filtering"
+	"go.einride.tech/aip/ordering"
+	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+)
+
+// maxFilterLength bounds the size of a filter expression
+const maxFilterLength = 1024
+
+// listField is a field List requests can filter and order by
+type listField[T any] struct {
+	column string
+	time   bool
+	// filterOnly fields can't be ordered by. Nullable columns are, since
+	// their NULLs can't be compared to find the next page.
+	filterOnly bool
+	value      func(T) any
+}
+
+// listing holds the fields of a resource that can be used in the filter and
+// order_by of its List RPC. Any other field is rejected, so requests can
+// only touch columns that are meant to be queried.
+type listing[T any] struct {
+	fields       map[string]listField[T]
+	declarations *filtering.Declarations
+	id           sortKey[T]
+	defaultOrder []sortKey[T]
+}
+
+func newListing[T any](fields map[string]listField[T], id sortKey[T], defaultOrder []sortKey[T]) *listing[T] {
+	opts := []filtering.DeclarationOption{filtering.DeclareStandardFunctions()}
+	for name, f := range fields {
+		t := filtering.TypeString
+		if f.time {
+			t = filtering.TypeTimestamp
+		}
+		opts = append(opts, filtering.DeclareIdent(name, t))
+	}
+	declarations, err := filtering.NewDeclarations(opts...)
+	if err != nil {
+		panic(fmt.Sprintf("invalid filter declarations: %v", err))
+	}
+	return &listing[T]{
+		fields:       fields,
+		declarations: declarations,
+		id:           id,
+		defaultOrder: defaultOrder,
+	}
+}
+
+// Fields of the resources with filter and order_by support
+var (
+	userListing = newListing(map[string]listField[*ent.User]{
+		"email":      {column: user.FieldEmail, filterOnly: true},
+		"name":       {column: user.FieldName, value: func(u *ent.User) any { return u.Name }},
+		"role":       {column: user.FieldRole, value: func(u *ent.User) any { return u.Role }},
+		"created_at": {column: user.FieldCreatedAt, time: true, value: func(u *ent.User) any { return u.CreatedAt }},
+		"updated_at": {column: user.FieldUpdatedAt, time: true, value: func(u *ent.User) any { return u.UpdatedAt }},
+	}, usersByCreation[len(usersByCreation)-1], usersByCreation)
+
+	influencerListing = newListing(map[string]listField[*ent.Influencer]{
+		"name":       {column: influencer.FieldName, value: func(i *ent.Influencer) any { return i.Name }},
+		"platform":   {column: influencer.FieldPlatform, value: func(i *ent.Influencer) any { return i.Platform }},
+		"account_id": {column: influencer.FieldAccountID, filterOnly: true},
+		"status":     {column: influencer.FieldStatus, value: func(i *ent.Influencer) any { return i.Status }},
+		"created_at": {column: influencer.FieldCreatedAt, time: true, value: func(i *ent.Influencer) any { return i.CreatedAt }},
+		"updated_at": {column: influencer.FieldUpdatedAt, time: true, value: func(i *ent.Influencer) any { return i.UpdatedAt }},
+	}, influencersByCreation[len(influencersByCreation)-1], influencersByCreation)
+
+	postListing = newListing(map[string]listField[*ent.Post]{
+		"status":         {column: post.FieldStatus, value: func(p *ent.Post) any { return p.Status }},
+		"scheduled_time": {column: post.FieldScheduledTime, time: true, value: func(p *ent.Post) any { return p.ScheduledTime }},
+		"created_at":     {column: post.FieldCreatedAt, time: true, value: func(p *ent.Post) any { return p.CreatedAt }},
+		"updated_at":     {column: post.FieldUpdatedAt, time: true, value: func(p *ent.Post) any { return p.UpdatedAt }},
+	}, postsBySchedule[len(postsBySchedule)-1], postsBySchedule)
+)
+
+// condition builds a predicate on the selected table
+type condition func(s *sql.Selector) *sql.Predicate
+
+// filter parses an AIP-160 filter such as
+// `status = "scheduled" AND scheduled_time >= "2025-01-01T00:00:00Z"` into
+// a predicate. An empty filter matches everything.
+func (l *listing[T]) filter(req filtering.Request) (func(*sql.Selector), error) {
+	if len(req.GetFilter()) > maxFilterLength {
+		return nil, status.Errorf(codes.InvalidArgument, "filter must be at most %d characters", maxFilterLength)
+	}
+	f, err := filtering.ParseFilter(req, l.declarations)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	if f.CheckedExpr == nil {
+		return func(*sql.Selector) {}, nil
+	}
+	cond, err := l.condition(f.CheckedExpr.GetExpr())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	return func(s *sql.Selector) {
+		s.Where(cond(s))
+	}, nil
+}
+
+func (l *listing[T]) condition(e *expr.Expr) (condition, error) {
+	call := e.GetCallExpr()
+	if call == nil {
+		return nil, fmt.Errorf("expected a comparison")
+	}
+
+	switch fn := call.GetFunction(); fn {
+	case filtering.FunctionAnd, filtering.FunctionFuzzyAnd, filtering.FunctionOr:
+		conds := make([]condition, len(call.GetArgs()))
+		for i, arg := range call.GetArgs() {
+			var err error
+			if conds[i], err = l.condition(arg); err != nil {
+				return nil, err
+			}
+		}
+		combine := sql.And
+		if fn == filtering.FunctionOr {
+			combine = sql.Or
+		}
+		return func(s *sql.Selector) *sql.Predicate {
+			preds := make([]*sql.Predicate, len(conds))
+			for i, cond := range conds {
+				preds[i] = cond(s)
+			}
+			return combine(preds...)
+		}, nil
+
+	case filtering.FunctionNot:
+		cond, err := l.condition(call.GetArgs()[0])
+		if err != nil {
+			return nil, err
+		}
+		return func(s *sql.Selector) *sql.Predicate {
+			return sql.Not(cond(s))
+		}, nil
+
+	case filtering.FunctionEquals, filtering.FunctionNotEquals,
+		filtering.FunctionLessThan, filtering.FunctionLessEquals,
+		filtering.FunctionGreaterThan, filtering.FunctionGreaterEquals:
+		ident := call.GetArgs()[0].GetIdentExpr()
+		if ident == nil {
+			return nil, fmt.Errorf("the left side of %s must be a field", fn)
+		}
+		f, ok := l.fields[ident.GetName()]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", ident.GetName())
+		}
+		value, err := literal(call.GetArgs()[1], f.time)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ident.GetName(), err)
+		}
+		compare := map[string]func(string, any) *sql.Predicate{
+			filtering.FunctionEquals:        sql.EQ,
+			filtering.FunctionNotEquals:     sql.NEQ,
+			filtering.FunctionLessThan:      sql.LT,
+			filtering.FunctionLessEquals:    sql.LTE,
+			filtering.FunctionGreaterThan:   sql.GT,
+			filtering.FunctionGreaterEquals: sql.GTE,
+		}[fn]
+		return func(s *sql.Selector) *sql.Predicate {
+			return compare(s.C(f.column), value)
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported operator %q", fn)
+	}
+}
+
+// literal returns the value of the right side of a comparison. Times are
+// RFC 3339 strings, optionally wrapped in timestamp().
+func literal(e *expr.Expr, isTime bool) (any, error) {
+	if call := e.GetCallExpr(); call != nil && call.GetFunction() == filtering.FunctionTimestamp {
+		e = call.GetArgs()[0]
+	}
+	c := e.GetConstExpr()
+	if c == nil {
+		return nil, fmt.Errorf("expected a quoted value")
+	}
+	v := c.GetStringValue()
+	if !isTime {
+		return v, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("expected an RFC 3339 time, got %q", v)
+	}
+	return t, nil
+}
+
+// order parses an AIP-132 order_by such as "scheduled_time desc, status"
+// into sort keys, with the ID last to make the order total. An empty
+// order_by gives the resource's default order.
+func (l *listing[T]) order(req ordering.Request) ([]sortKey[T], error) {
+	orderBy, err := ordering.ParseOrderBy(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order_by: %v", err)
+	}
+	if len(orderBy.Fields) == 0 {
+		return l.defaultOrder, nil
+	}
+
+	keys := make([]sortKey[T], 0, len(orderBy.Fields)+1)
+	seen := make(map[string]bool)
+	for _, of := range orderBy.Fields {
+		f, ok := l.fields[of.Path]
+		if !ok || f.filterOnly {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order_by: cannot order by %q", of.Path)
+		}
+		if seen[of.Path] {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order_by: %q is listed twice", of.Path)
+		}
+		seen[of.Path] = true
+		keys = append(keys, sortKey[T]{column: f.column, desc: of.Desc, value: f.value})
+	}
+	return append(keys, l.id), nil
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+func TestOrderRejectsNullableFields(t *testing.T) {
+	if _, err := userListing.order(&ocsv1.ListUsersRequest{OrderBy: "name desc"}); err != nil {
+		t.Fatalf("order by name: %v", err)
+	}
+
+	for _, orderBy := range []string{"email", "name, email desc"} {
+		_, err := userListing.order(&ocsv1.ListUsersRequest{OrderBy: orderBy})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("order by %q: got %v, want INVALID_ARGUMENT", orderBy, err)
+		}
+	}
+	if _, err := userListing.filter(&ocsv1.ListUsersRequest{Filter: `email = "a@example.com"`}); err != nil {
+		t.Errorf("filter on email: %v", err)
+	}
+
+	_, err := influencerListing.order(&ocsv1.ListInfluencersRequest{OrderBy: "account_id"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("order by account_id: got %v, want INVALID_ARGUMENT", err)
+	}
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/notification"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// Notifications
+func (s *Server) ListNotifications(ctx context.Context, req *ocsv1.ListNotificationsRequest) (*ocsv1.ListNotificationsResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the query
+	query := s.client.Notification.Query().
+		Where(notification.UserID(principal.UserID))
+	if req.UnreadOnly {
+		query = query.Where(notification.ReadAtIsNil())
+	}
+
+	// Apply pagination; IDs are time-ordered so this lists oldest first
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, notificationsByID...)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(page.where).Order(page.order).Limit(page.limit())
+
+	notifications, err := query.All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list notifications: %v", err)
+	}
+	notifications, nextPageToken := page.trim(notifications)
+
+	protoNotifications := make([]*ocsv1.Notification, len(notifications))
+	for i, n := range notifications {
+		protoNotifications[i] = toProtoNotification(n)
+	}
+
+	return &ocsv1.ListNotificationsResponse{
+		Notifications: protoNotifications,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) MarkNotificationRead(ctx context.Context, req *ocsv1.MarkNotificationReadRequest) (*ocsv1.MarkNotificationReadResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users can only see their own notifications
+	n, err := s.client.Notification.Query().
+		Where(
+			notification.ID(req.Id),
+			notification.UserID(principal.UserID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "notification not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get notification: %v", err)
+	}
+
+	if n.ReadAt == nil {
+		n, err = n.Update().
+			SetReadAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update notification: %v", err)
+		}
+	}
+
+	return &ocsv1.MarkNotificationReadResponse{
+		Notification: toProtoNotification(n),
+	}, nil
+}
@@ -0,0 +1,322 @@
+package server
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/membership"
+	"github.com/WuPinYi/SocialForge/internal/ent/organization"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// Organization Management
+func (s *Server) CreateOrganization(ctx context.Context, req *ocsv1.CreateOrganizationRequest) (*ocsv1.CreateOrganizationResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	org, err := tx.Organization.Create().
+		SetID(uuid.New().String()).
+		SetName(req.Name).
+		Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create organization: %v", err)
+	}
+
+	// The creator becomes the first owner
+	_, err = tx.Membership.Create().
+		SetID(uuid.New().String()).
+		SetOrganization(org).
+		SetUserID(principal.UserID).
+		SetRole(roleOwner).
+		Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create membership: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create organization: %v", err)
+	}
+
+	return &ocsv1.CreateOrganizationResponse{
+		Organization: toProtoOrganization(org),
+	}, nil
+}
+
+func (s *Server) ListOrganizations(ctx context.Context, req *ocsv1.ListOrganizationsRequest) (*ocsv1.ListOrganizationsResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the personal organization shows up on first use
+	if _, err := s.personalMembership(ctx, principal); err != nil {
+		return nil, err
+	}
+
+	// Build the query; admin can see every organization
+	query := s.client.Organization.Query()
+	if !principal.IsAdmin() {
+		query = query.Where(organization.HasMembershipsWith(membership.UserID(principal.UserID)))
+	}
+
+	// Apply pagination, oldest first
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, organizationsByCreation...)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(page.where).Order(page.order).Limit(page.limit())
+
+	orgs, err := query.All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list organizations: %v", err)
+	}
+	orgs, nextPageToken := page.trim(orgs)
+
+	protoOrgs := make([]*ocsv1.Organization, len(orgs))
+	for i, o := range orgs {
+		protoOrgs[i] = toProtoOrganization(o)
+	}
+
+	return &ocsv1.ListOrganizationsResponse{
+		Organizations: protoOrgs,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) AddMember(ctx context.Context, req *ocsv1.AddMemberRequest) (*ocsv1.AddMemberResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.activeOrganization(ctx, principal, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAssignableRole(org, req.Role); err != nil {
+		return nil, err
+	}
+
+	// Get the user to add
+	exists, err := s.client.User.Query().Where(user.ID(req.UserId)).Exist(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	m, err := s.client.Membership.Create().
+		SetID(uuid.New().String()).
+		SetOrganizationID(org.OrganizationID).
+		SetUserID(req.UserId).
+		SetRole(req.Role).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Error(codes.AlreadyExists, "user is already a member")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to add member: %v", err)
+	}
+
+	return &ocsv1.AddMemberResponse{
+		Membership: toProtoMembership(m),
+	}, nil
+}
+
+func (s *Server) UpdateMember(ctx context.Context, req *ocsv1.UpdateMemberRequest) (*ocsv1.UpdateMemberResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.activeOrganization(ctx, principal, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.getMembership(ctx, org, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The role is the only field that can change
+	paths, err := updatePaths(req, req.UpdateMask, "role")
+	if err != nil {
+		return nil, err
+	}
+	if !paths["role"] {
+		return &ocsv1.UpdateMemberResponse{
+			Membership: toProtoMembership(m),
+		}, nil
+	}
+	if err := checkAssignableRole(org, req.Role); err != nil {
+		return nil, err
+	}
+
+	// Only owners can change the role of another owner
+	if m.Role == roleOwner && org.Role != roleOwner {
+		return nil, status.Error(codes.PermissionDenied, "only owners can change the role of an owner")
+	}
+	if m.Role == roleOwner && req.Role != roleOwner {
+		if err := s.checkNotLastOwner(ctx, org); err != nil {
+			return nil, err
+		}
+	}
+
+	m, err = m.Update().
+		SetRole(req.Role).
+		Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update member: %v", err)
+	}
+
+	return &ocsv1.UpdateMemberResponse{
+		Membership: toProtoMembership(m),
+	}, nil
+}
+
+func (s *Server) RemoveMember(ctx context.Context, req *ocsv1.RemoveMemberRequest) (*ocsv1.RemoveMemberResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members may always leave; removing others requires admin
+	minRole := roleAdmin
+	if req.UserId == principal.UserID {
+		minRole = roleViewer
+	}
+	org, err := s.activeOrganization(ctx, principal, minRole)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.getMembership(ctx, org, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Role == roleOwner {
+		if org.Role != roleOwner {
+			return nil, status.Error(codes.PermissionDenied, "only owners can remove an owner")
+		}
+		if err := s.checkNotLastOwner(ctx, org); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.client.Membership.DeleteOne(m).Exec(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove member: %v", err)
+	}
+
+	return &ocsv1.RemoveMemberResponse{}, nil
+}
+
+func (s *Server) ListMembers(ctx context.Context, req *ocsv1.ListMembersRequest) (*ocsv1.ListMembersResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.activeOrganization(ctx, principal, roleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the query
+	query := s.client.Membership.Query().Where(membership.OrganizationID(org.OrganizationID))
+
+	// Apply pagination, oldest first
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, membershipsByCreation...)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(page.where).Order(page.order).Limit(page.limit())
+
+	memberships, err := query.All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list members: %v", err)
+	}
+	memberships, nextPageToken := page.trim(memberships)
+
+	protoMemberships := make([]*ocsv1.Membership, len(memberships))
+	for i, m := range memberships {
+		protoMemberships[i] = toProtoMembership(m)
+	}
+
+	return &ocsv1.ListMembersResponse{
+		Memberships:   protoMemberships,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// getMembership loads the membership of userID in the organization
+func (s *Server) getMembership(ctx context.Context, org *orgAccess, userID string) (*ent.Membership, error) {
+	m, err := s.client.Membership.Query().
+		Where(
+			membership.OrganizationID(org.OrganizationID),
+			membership.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "membership not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get membership: %v", err)
+	}
+	return m, nil
+}
+
+// checkNotLastOwner prevents an organization from being left without an owner
+func (s *Server) checkNotLastOwner(ctx context.Context, org *orgAccess) error {
+	owners, err := s.client.Membership.Query().
+		Where(
+			membership.OrganizationID(org.OrganizationID),
+			membership.Role(roleOwner),
+		).
+		Count(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count owners: %v", err)
+	}
+	if owners <= 1 {
+		return status.Error(codes.FailedPrecondition, "organization must keep at least one owner")
+	}
+	return nil
+}
+
+// checkAssignableRole validates role and checks the caller may grant it
+func checkAssignableRole(org *orgAccess, role string) error {
+	if !slices.Contains(orgRoles, role) {
+		return status.Errorf(codes.InvalidArgument, "unknown role %q", role)
+	}
+	if role == roleOwner && org.Role != roleOwner {
+		return status.Error(codes.PermissionDenied, "only owners can grant the owner role")
+	}
+	return nil
+}
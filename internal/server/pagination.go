@@ -0,0 +1,223 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/auditevent"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/membership"
+	"github.com/WuPinYi/SocialForge/internal/ent/notification"
+	"github.com/WuPinYi/SocialForge/internal/ent/organization"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/ent/serviceaccount"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+	"github.com/WuPinYi/SocialForge/internal/pagetoken"
+)
+
+// Page sizes of the List RPCs
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// sortKey is a column rows are ordered by, along with how to read its value
+// from a row. Values must be strings or times and never NULL.
+type sortKey[T any] struct {
+	column string
+	desc   bool
+	value  func(T) any
+}
+
+// Orders the List RPCs return rows in. Every order ends with the ID so that
+// it is total and each row has a unique position.
+var (
+	usersByCreation = []sortKey[*ent.User]{
+		{column: user.FieldCreatedAt, value: func(u *ent.User) any { return u.CreatedAt }},
+		{column: user.FieldID, value: func(u *ent.User) any { return u.ID }},
+	}
+	influencersByCreation = []sortKey[*ent.Influencer]{
+		{column: influencer.FieldCreatedAt, value: func(i *ent.Influencer) any { return i.CreatedAt }},
+		{column: influencer.FieldID, value: func(i *ent.Influencer) any { return i.ID }},
+	}
+	postsBySchedule = []sortKey[*ent.Post]{
+		{column: post.FieldScheduledTime, value: func(p *ent.Post) any { return p.ScheduledTime }},
+		{column: post.FieldID, value: func(p *ent.Post) any { return p.ID }},
+	}
+	postsByLatestSchedule = []sortKey[*ent.Post]{
+		{column: post.FieldScheduledTime, desc: true, value: func(p *ent.Post) any { return p.ScheduledTime }},
+		{column: post.FieldID, desc: true, value: func(p *ent.Post) any { return p.ID }},
+	}
+	influencersByDeletion = []sortKey[*ent.Influencer]{
+		{column: influencer.FieldDeletedAt, desc: true, value: func(i *ent.Influencer) any { return *i.DeletedAt }},
+		{column: influencer.FieldID, desc: true, value: func(i *ent.Influencer) any { return i.ID }},
+	}
+	postsByDeletion = []sortKey[*ent.Post]{
+		{column: post.FieldDeletedAt, desc: true, value: func(p *ent.Post) any { return *p.DeletedAt }},
+		{column: post.FieldID, desc: true, value: func(p *ent.Post) any { return p.ID }},
+	}
+	grantsByCreation = []sortKey[*ent.AccessGrant]{
+		{column: accessgrant.FieldCreatedAt, value: func(g *ent.AccessGrant) any { return g.CreatedAt }},
+		{column: accessgrant.FieldID, value: func(g *ent.AccessGrant) any { return g.ID }},
+	}
+	organizationsByCreation = []sortKey[*ent.Organization]{
+		{column: organization.FieldCreatedAt, value: func(o *ent.Organization) any { return o.CreatedAt }},
+		{column: organization.FieldID, value: func(o *ent.Organization) any { return o.ID }},
+	}
+	membershipsByCreation = []sortKey[*ent.Membership]{
+		{column: membership.FieldCreatedAt, value: func(m *ent.Membership) any { return m.CreatedAt }},
+		{column: membership.FieldID, value: func(m *ent.Membership) any { return m.ID }},
+	}
+	serviceAccountsByCreation = []sortKey[*ent.ServiceAccount]{
+		{column: serviceaccount.FieldCreatedAt, value: func(sa *ent.ServiceAccount) any { return sa.CreatedAt }},
+		{column: serviceaccount.FieldID, value: func(sa *ent.ServiceAccount) any { return sa.ID }},
+	}
+	// Audit event and notification IDs are time-ordered
+	auditEventsByID = []sortKey[*ent.AuditEvent]{
+		{column: auditevent.FieldID, value: func(e *ent.AuditEvent) any { return e.ID }},
+	}
+	notificationsByID = []sortKey[*ent.Notification]{
+		{column: notification.FieldID, value: func(n *ent.Notification) any { return n.ID }},
+	}
+)
+
+// page is the requested page of a list ordered by keys. Its where, order
+// and limit are applied to the query, and trim cuts the result down to the
+// page and returns the token for the next one.
+type page[T any] struct {
+	keys   []sortKey[T]
+	size   int
+	query  string
+	after  []any
+	tokens *pagetoken.Signer
+}
+
+// newPage checks the page size and token of a List request. The token must
+// have been returned for the same request, apart from its page size.
+func newPage[T any](tokens *pagetoken.Signer, req proto.Message, pageSize int32, pageToken string, keys ...sortKey[T]) (*page[T], error) {
+	p := &page[T]{
+		keys:   keys,
+		size:   defaultPageSize,
+		query:  queryID(req, keys),
+		tokens: tokens,
+	}
+
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize > maxPageSize:
+		p.size = maxPageSize
+	case pageSize > 0:
+		p.size = int(pageSize)
+	}
+
+	if pageToken == "" {
+		return p, nil
+	}
+	token, err := tokens.Decode(pageToken, p.query)
+	if err != nil || len(token.After) != len(keys) {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token; it must come from a previous call with the same parameters")
+	}
+	p.after = make([]any, len(keys))
+	for i, v := range token.After {
+		if p.after[i], err = pagetoken.ParseValue(v); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+	}
+	return p, nil
+}
+
+// where selects the rows after the previous page
+func (p *page[T]) where(s *sql.Selector) {
+	if p.after == nil {
+		return
+	}
+
+	// (a > x) OR (a = x AND b > y) OR ...
+	ors := make([]*sql.Predicate, len(p.keys))
+	for i, key := range p.keys {
+		ands := make([]*sql.Predicate, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, sql.EQ(s.C(p.keys[j].column), p.after[j]))
+		}
+		if key.desc {
+			ands = append(ands, sql.LT(s.C(key.column), p.after[i]))
+		} else {
+			ands = append(ands, sql.GT(s.C(key.column), p.after[i]))
+		}
+		ors[i] = sql.And(ands...)
+	}
+	s.Where(sql.Or(ors...))
+}
+
+// order sorts the rows by the keys
+func (p *page[T]) order(s *sql.Selector) {
+	for _, key := range p.keys {
+		if key.desc {
+			s.OrderBy(sql.Desc(s.C(key.column)))
+		} else {
+			s.OrderBy(sql.Asc(s.C(key.column)))
+		}
+	}
+}
+
+// limit fetches one row more than the page holds to tell whether there is
+// a next page
+func (p *page[T]) limit() int {
+	return p.size + 1
+}
+
+// trim cuts rows down to the page and returns the token for the next page,
+// which is empty on the last page
+func (p *page[T]) trim(rows []T) ([]T, string) {
+	if len(rows) <= p.size {
+		return rows, ""
+	}
+	rows = rows[:p.size]
+
+	last := rows[len(rows)-1]
+	after := make([]string, len(p.keys))
+	for i, key := range p.keys {
+		after[i] = pagetoken.FormatValue(key.value(last))
+	}
+	return rows, p.tokens.Encode(&pagetoken.Token{Query: p.query, After: after})
+}
+
+// queryID identifies a query by its request, without the page size and
+// token, and its order
+func queryID[T any](req proto.Message, keys []sortKey[T]) string {
+	req = proto.Clone(req)
+	fields := req.ProtoReflect().Descriptor().Fields()
+	for _, name := range []string{"page_size", "page_token"} {
+		if fd := fields.ByName(protoreflect.Name(name)); fd != nil {
+			req.ProtoReflect().Clear(fd)
+		}
+	}
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+
+	var order strings.Builder
+	for _, key := range keys {
+		order.WriteString(key.column)
+		if key.desc {
+			order.WriteString(" desc")
+		}
+		order.WriteString(",")
+	}
+
+	h := sha256.New()
+	h.Write([]byte(req.ProtoReflect().Descriptor().FullName()))
+	h.Write([]byte{0})
+	h.Write([]byte(order.String()))
+	h.Write([]byte{0})
+	h.Write(b)
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil)[:12])
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+	"github.com/WuPinYi/SocialForge/internal/search"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// Search
+func (s *Server) SearchPosts(ctx context.Context, req *ocsv1.SearchPostsRequest) (*ocsv1.SearchPostsResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := search.Parse(req.Query)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+
+	// Search the influencers of the active organization and those shared
+	// with the caller, like the calendar
+	org, err := s.activeOrganization(ctx, principal, roleViewer)
+	if err != nil {
+		return nil, err
+	}
+	visible := visibleInfluencers(principal, org)
+	if len(req.InfluencerIds) > 0 {
+		visible = influencer.And(visible, influencer.IDIn(req.InfluencerIds...))
+	}
+
+	filter, err := postListing.filter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply pagination, most recently scheduled first
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, postsByLatestSchedule...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Posts match by their content or their influencer's name
+	posts, err := s.client.Post.Query().
+		Where(
+			post.HasInfluencerWith(visible, influencer.DeletedAtIsNil()),
+			post.Or(
+				predicate.Post(func(s *sql.Selector) {
+					s.Where(query.Match(s, post.FieldContent))
+				}),
+				post.HasInfluencerWith(func(s *sql.Selector) {
+					s.Where(query.Match(s, influencer.FieldName))
+				}),
+			),
+		).
+		Where(filter).
+		Where(page.where).
+		Order(page.order).
+		Limit(page.limit()).
+		WithInfluencer().
+		All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search posts: %v", err)
+	}
+	posts, nextPageToken := page.trim(posts)
+
+	results := make([]*ocsv1.PostSearchResult, len(posts))
+	for i, p := range posts {
+		results[i] = &ocsv1.PostSearchResult{
+			Post:           toProtoPost(p),
+			Snippet:        query.Snippet(p.Content),
+			InfluencerName: query.Highlight(p.Edges.Influencer.Name),
+		}
+	}
+
+	return &ocsv1.SearchPostsResponse{
+		Results:       results,
+		NextPageToken: nextPageToken,
+	}, nil
+}
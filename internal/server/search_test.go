@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+func TestSearchPostsScope(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	ctx := context.Background()
+	s := NewServer(client)
+
+	alice := client.User.Create().SetID("user-alice").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	bob := client.User.Create().SetID("user-bob").SetName("Bob").SetAuth0ID("auth0|bob").SaveX(ctx)
+
+	// Influencers without an organization are adopted into their owner's
+	// personal organization on first use
+	own := client.Influencer.Create().SetID("inf-own").SetName("Alice").SetPlatform("x").SetOwner(alice).SaveX(ctx)
+	shared := client.Influencer.Create().SetID("inf-shared").SetName("Bob").SetPlatform("x").SetOwner(bob).SaveX(ctx)
+	private := client.Influencer.Create().SetID("inf-private").SetName("Bob").SetPlatform("x").SetOwner(bob).SaveX(ctx)
+	client.AccessGrant.Create().
+		SetID("grant-1").
+		SetInfluencer(shared).
+		SetUser(alice).
+		SetGrantedBy(bob.ID).
+		ExecX(ctx)
+
+	for i, inf := range []*ent.Influencer{own, shared, private} {
+		client.Post.Create().
+			SetID("post-" + inf.ID).
+			SetInfluencer(inf).
+			SetContent("Product launch").
+			SetScheduledTime(time.Now().Add(time.Duration(i+1) * time.Hour)).
+			ExecX(ctx)
+	}
+
+	// Bob's personal organization adopts his influencers
+	bobCtx := auth.NewContext(ctx, &auth.Principal{Kind: auth.PrincipalUser, Subject: bob.Auth0ID, UserID: bob.ID})
+	if _, err := s.SearchPosts(bobCtx, &ocsv1.SearchPostsRequest{Query: "launch"}); err != nil {
+		t.Fatalf("search as bob: %v", err)
+	}
+
+	aliceCtx := auth.NewContext(ctx, &auth.Principal{Kind: auth.PrincipalUser, Subject: alice.Auth0ID, UserID: alice.ID})
+	tests := []struct {
+		query         string
+		influencerIDs []string
+		want          []string
+	}{
+		{query: `"product launch"`, want: []string{"post-inf-shared", "post-inf-own"}},
+		{query: `launch*`, influencerIDs: []string{own.ID}, want: []string{"post-inf-own"}},
+		{query: `launch*`, influencerIDs: []string{private.ID}, want: nil},
+		{query: `bob`, want: []string{"post-inf-shared"}},
+	}
+	for _, tt := range tests {
+		resp, err := s.SearchPosts(aliceCtx, &ocsv1.SearchPostsRequest{Query: tt.query, InfluencerIds: tt.influencerIDs})
+		if err != nil {
+			t.Fatalf("search %q: %v", tt.query, err)
+		}
+		var got []string
+		for _, r := range resp.Results {
+			got = append(got, r.Post.Id)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("search %q in %v: got %v, want %v", tt.query, tt.influencerIDs, got, tt.want)
+		}
+	}
+}
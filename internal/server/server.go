@@ -2,41 +2,99 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/connect"
 	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
 	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/membership"
 	"github.com/WuPinYi/SocialForge/internal/ent/post"
 	"github.com/WuPinYi/SocialForge/internal/ent/user"
+	"github.com/WuPinYi/SocialForge/internal/pagetoken"
+	"github.com/WuPinYi/SocialForge/internal/postevents"
+	"github.com/WuPinYi/SocialForge/internal/vault"
 	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
 )
 
 type Server struct {
 	ocsv1.UnimplementedOpinionControlServiceServer
-	client *ent.Client
+	client      *ent.Client
+	credentials *vault.Store
+	connect     *connect.Flow
+	pageTokens  *pagetoken.Signer
+	postEvents  *postevents.Broker
 }
 
-func NewServer(client *ent.Client) *Server {
-	return &Server{
+// Option configures optional behaviour of the Server
+type Option func(*Server)
+
+// WithCredentialStore enables storing encrypted platform credentials
+func WithCredentialStore(store *vault.Store) Option {
+	return func(s *Server) {
+		s.credentials = store
+	}
+}
+
+// WithConnectFlow enables connecting influencer accounts through OAuth
+func WithConnectFlow(flow *connect.Flow) Option {
+	return func(s *Server) {
+		s.connect = flow
+	}
+}
+
+// WithPageTokenSigner signs page tokens with a key shared by all replicas.
+// Without it tokens are signed with a random key and only work on this
+// replica until it restarts.
+func WithPageTokenSigner(signer *pagetoken.Signer) Option {
+	return func(s *Server) {
+		s.pageTokens = signer
+	}
+}
+
+// WithPostEvents enables WatchPosts, streaming the events published to the
+// broker
+func WithPostEvents(broker *postevents.Broker) Option {
+	return func(s *Server) {
+		s.postEvents = broker
+	}
+}
+
+func NewServer(client *ent.Client, opts ...Option) *Server {
+	s := &Server{
 		client: client,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.pageTokens == nil {
+		s.pageTokens = pagetoken.NewRandomSigner()
+	}
+	return s
 }
 
 // User Management
 func (s *Server) GetUser(ctx context.Context, req *ocsv1.GetUserRequest) (*ocsv1.GetUserResponse, error) {
-	// Get the authenticated user's claims
-	claims, err := auth.GetUserFromContext(ctx)
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	// An empty ID or "me" refers to the caller
+	id := req.Id
+	if id == "" || id == "me" {
+		id = principal.UserID
+	}
+
 	// Get the user from the database
-	u, err := s.client.User.Get(ctx, req.Id)
+	u, err := s.client.User.Get(ctx, id)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, status.Error(codes.NotFound, "user not found")
@@ -45,75 +103,71 @@ func (s *Server) GetUser(ctx context.Context, req *ocsv1.GetUserRequest) (*ocsv1
 	}
 
 	// Check if the user has permission to view this user
-	if u.Auth0ID != claims.Subject && claims.Subject != "admin" {
+	if u.Auth0ID != principal.Subject && !principal.IsAdmin() {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
 	return &ocsv1.GetUserResponse{
-		User: &ocsv1.User{
-			Id:        u.ID,
-			Email:     u.Email,
-			Name:      u.Name,
-			Role:      u.Role,
-			CreatedAt: timestamppb.New(u.CreatedAt),
-			UpdatedAt: timestamppb.New(u.UpdatedAt),
-		},
+		User: toProtoUser(u),
 	}, nil
 }
 
 func (s *Server) ListUsers(ctx context.Context, req *ocsv1.ListUsersRequest) (*ocsv1.ListUsersResponse, error) {
-	// Get the authenticated user's claims
-	claims, err := auth.GetUserFromContext(ctx)
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Only admin can list all users
-	if claims.Subject != "admin" {
-		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	// Users are listed as members of the active organization; only admin
+	// can list all users by not selecting an organization
+	query := s.client.User.Query()
+	if !principal.IsAdmin() || requestedOrganization(ctx) != "" {
+		org, err := s.activeOrganization(ctx, principal, roleViewer)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(user.HasMembershipsWith(membership.OrganizationID(org.OrganizationID)))
 	}
 
-	query := s.client.User.Query()
+	// Apply the filter and order
+	filter, err := userListing.filter(req)
+	if err != nil {
+		return nil, err
+	}
+	order, err := userListing.order(req)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(filter)
 
 	// Apply pagination
-	if req.PageSize > 0 {
-		query = query.Limit(int(req.PageSize))
-	}
-	if req.PageToken != "" {
-		query = query.Where(user.IDGT(req.PageToken))
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, order...)
+	if err != nil {
+		return nil, err
 	}
+	query = query.Where(page.where).Order(page.order).Limit(page.limit())
 
 	users, err := query.All(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
 	}
+	users, nextPageToken := page.trim(users)
 
 	protoUsers := make([]*ocsv1.User, len(users))
 	for i, u := range users {
-		protoUsers[i] = &ocsv1.User{
-			Id:        u.ID,
-			Email:     u.Email,
-			Name:      u.Name,
-			Role:      u.Role,
-			CreatedAt: timestamppb.New(u.CreatedAt),
-			UpdatedAt: timestamppb.New(u.UpdatedAt),
-		}
+		protoUsers[i] = toProtoUser(u)
 	}
 
 	return &ocsv1.ListUsersResponse{
-		Users: protoUsers,
-		NextPageToken: func() string {
-			if len(users) > 0 {
-				return users[len(users)-1].ID
-			}
-			return ""
-		}(),
+		Users:         protoUsers,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 func (s *Server) UpdateUser(ctx context.Context, req *ocsv1.UpdateUserRequest) (*ocsv1.UpdateUserResponse, error) {
-	// Get the authenticated user's claims
-	claims, err := auth.GetUserFromContext(ctx)
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -128,207 +182,250 @@ func (s *Server) UpdateUser(ctx context.Context, req *ocsv1.UpdateUserRequest) (
 	}
 
 	// Check if the user has permission to update this user
-	if u.Auth0ID != claims.Subject && claims.Subject != "admin" {
+	if u.Auth0ID != principal.Subject && !principal.IsAdmin() {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
+	// Work out which fields to change
+	paths, err := updatePaths(req, req.UpdateMask, "name", "role")
+	if err != nil {
+		return nil, err
+	}
+
 	// Only admin can update roles
-	if req.Role != "" && claims.Subject != "admin" {
+	if paths["role"] && !principal.IsAdmin() {
 		return nil, status.Error(codes.PermissionDenied, "only admin can update roles")
 	}
+	if paths["role"] && req.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "role can't be cleared")
+	}
+
+	// Reject the write if the user changed since the caller read it
+	if err := checkETag(req.Etag, u.UpdatedAt); err != nil {
+		return nil, err
+	}
 
 	// Update the user
-	update := s.client.User.UpdateOne(u)
-	if req.Name != "" {
+	update := s.client.User.UpdateOne(u).
+		Where(user.UpdatedAtEQ(u.UpdatedAt))
+	if paths["name"] {
 		update.SetName(req.Name)
 	}
-	if req.Role != "" {
+	if paths["role"] {
 		update.SetRole(req.Role)
 	}
 
 	u, err = update.Save(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errConcurrentUpdate("user", req.Etag)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
 
 	return &ocsv1.UpdateUserResponse{
-		User: &ocsv1.User{
-			Id:        u.ID,
-			Email:     u.Email,
-			Name:      u.Name,
-			Role:      u.Role,
-			CreatedAt: timestamppb.New(u.CreatedAt),
-			UpdatedAt: timestamppb.New(u.UpdatedAt),
-		},
+		User: toProtoUser(u),
 	}, nil
 }
 
 // Influencer Management
 func (s *Server) CreateInfluencer(ctx context.Context, req *ocsv1.CreateInfluencerRequest) (*ocsv1.CreateInfluencerResponse, error) {
-	// Get the authenticated user's claims
-	claims, err := auth.GetUserFromContext(ctx)
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the user
-	u, err := s.client.User.Query().Where(user.Auth0IDEQ(claims.Subject)).Only(ctx)
+	// Influencers are created in the caller's active organization
+	org, err := s.activeOrganization(ctx, principal, roleAdmin)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			// Create the user if they don't exist
-			u, err = s.client.User.Create().
-				SetID(uuid.New().String()).
-				SetEmail(claims.Email).
-				SetName(claims.Name).
-				SetAuth0ID(claims.Subject).
-				SetRole("user").
-				Save(ctx)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
-			}
-		} else {
-			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
-		}
+		return nil, err
 	}
 
-	// Create the influencer
+	// Create the influencer; its account is set once it is connected
 	influencer, err := s.client.Influencer.Create().
 		SetID(uuid.New().String()).
 		SetName(req.Name).
 		SetPlatform(req.Platform).
-		SetAccountID(req.AccountId).
-		SetOwner(u).
+		SetOwnerID(principal.UserID).
+		SetOrganizationID(org.OrganizationID).
 		Save(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create influencer: %v", err)
 	}
 
 	return &ocsv1.CreateInfluencerResponse{
-		Influencer: &ocsv1.Influencer{
-			Id:        influencer.ID,
-			Name:      influencer.Name,
-			Platform:  influencer.Platform,
-			AccountId: influencer.AccountID,
-			Status:    influencer.Status,
-			OwnerId:   u.ID,
-			CreatedAt: timestamppb.New(influencer.CreatedAt),
-			UpdatedAt: timestamppb.New(influencer.UpdatedAt),
-		},
+		Influencer: toProtoInfluencer(influencer, principal.UserID),
 	}, nil
 }
 
 func (s *Server) GetInfluencer(ctx context.Context, req *ocsv1.GetInfluencerRequest) (*ocsv1.GetInfluencerResponse, error) {
-	// Get the authenticated user's claims
-	claims, err := auth.GetUserFromContext(ctx)
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the influencer
-	influencer, err := s.client.Influencer.Get(ctx, req.Id)
+	// Get the influencer and check if the user has permission to view it
+	influencer, _, err := s.authorizeInfluencer(ctx, principal, req.Id, levelViewer)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, status.Error(codes.NotFound, "influencer not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get influencer: %v", err)
+		return nil, err
 	}
 
-	// Check if the user has permission to view this influencer
-	if influencer.Edges.Owner.Auth0ID != claims.Subject && claims.Subject != "admin" {
-		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	// Load the token expiry to report the token health
+	influencer.Edges.Credential, err = influencer.QueryCredential().
+		Select(credential.FieldInfluencerID, credential.FieldExpiresAt).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.Internal, "failed to get credential: %v", err)
 	}
 
 	return &ocsv1.GetInfluencerResponse{
-		Influencer: &ocsv1.Influencer{
-			Id:        influencer.ID,
-			Name:      influencer.Name,
-			Platform:  influencer.Platform,
-			AccountId: influencer.AccountID,
-			Status:    influencer.Status,
-			OwnerId:   influencer.Edges.Owner.ID,
-			CreatedAt: timestamppb.New(influencer.CreatedAt),
-			UpdatedAt: timestamppb.New(influencer.UpdatedAt),
-		},
+		Influencer: toProtoInfluencer(influencer, influencer.Edges.Owner.ID),
 	}, nil
 }
 
-func (s *Server) ListInfluencers(ctx context.Context, req *ocsv1.ListInfluencersRequest) (*ocsv1.ListInfluencersResponse, error) {
-	// Get the authenticated user's claims
-	claims, err := auth.GetUserFromContext(ctx)
+func (s *Server) UpdateInfluencer(ctx context.Context, req *ocsv1.UpdateInfluencerRequest) (*ocsv1.UpdateInfluencerResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the influencer and check if the user has permission to manage it
+	inf, _, err := s.authorizeInfluencer(ctx, principal, req.Id, levelManager)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the user
-	u, err := s.client.User.Query().Where(user.Auth0IDEQ(claims.Subject)).Only(ctx)
+	// Work out which fields to change
+	paths, err := updatePaths(req, req.UpdateMask, "name", "platform")
+	if err != nil {
+		return nil, err
+	}
+
+	// An account can only be replaced by connecting another one
+	if inf.AccountID != "" && paths["platform"] && req.Platform != inf.Platform {
+		return nil, status.Error(codes.FailedPrecondition, "the influencer has an account; connect one on the new platform instead")
+	}
+
+	// Reject the write if the influencer changed since the caller read it
+	if err := checkETag(req.Etag, inf.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	// Update the influencer
+	update := inf.Update().
+		Where(influencer.UpdatedAtEQ(inf.UpdatedAt))
+	if paths["name"] {
+		update.SetName(req.Name)
+	}
+	if paths["platform"] {
+		update.SetPlatform(req.Platform)
+	}
+
+	updated, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, status.Error(codes.NotFound, "user not found")
+			return nil, errConcurrentUpdate("influencer", req.Etag)
 		}
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update influencer: %v", err)
+	}
+
+	// Load the token expiry to report the token health
+	updated.Edges.Credential, err = updated.QueryCredential().
+		Select(credential.FieldInfluencerID, credential.FieldExpiresAt).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.Internal, "failed to get credential: %v", err)
+	}
+
+	return &ocsv1.UpdateInfluencerResponse{
+		Influencer: toProtoInfluencer(updated, inf.Edges.Owner.ID),
+	}, nil
+}
+
+func (s *Server) ListInfluencers(ctx context.Context, req *ocsv1.ListInfluencersRequest) (*ocsv1.ListInfluencersResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build the query
-	query := s.client.Influencer.Query().Where(influencer.HasOwnerWith(user.ID(u.ID)))
+	query := s.client.Influencer.Query()
+	if req.SharedWithMe {
+		query = query.Where(influencer.HasAccessGrantsWith(
+			accessgrant.UserID(principal.UserID),
+			accessgrant.Or(
+				accessgrant.ExpiresAtIsNil(),
+				accessgrant.ExpiresAtGT(time.Now()),
+			),
+		))
+	} else {
+		org, err := s.activeOrganization(ctx, principal, roleViewer)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(influencer.OrganizationID(org.OrganizationID))
+	}
 
-	// Apply pagination
-	if req.PageSize > 0 {
-		query = query.Limit(int(req.PageSize))
+	// Apply the filter and order
+	filter, err := influencerListing.filter(req)
+	if err != nil {
+		return nil, err
 	}
-	if req.PageToken != "" {
-		query = query.Where(influencer.IDGT(req.PageToken))
+	order, err := influencerListing.order(req)
+	if err != nil {
+		return nil, err
 	}
+	query = query.Where(filter)
 
-	influencers, err := query.WithOwner().All(ctx)
+	// Apply pagination
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, order...)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(page.where).Order(page.order).Limit(page.limit())
+
+	influencers, err := query.WithOwner().WithCredential(withTokenExpiry).All(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list influencers: %v", err)
 	}
+	influencers, nextPageToken := page.trim(influencers)
 
 	protoInfluencers := make([]*ocsv1.Influencer, len(influencers))
 	for i, inf := range influencers {
-		protoInfluencers[i] = &ocsv1.Influencer{
-			Id:        inf.ID,
-			Name:      inf.Name,
-			Platform:  inf.Platform,
-			AccountId: inf.AccountID,
-			Status:    inf.Status,
-			OwnerId:   inf.Edges.Owner.ID,
-			CreatedAt: timestamppb.New(inf.CreatedAt),
-			UpdatedAt: timestamppb.New(inf.UpdatedAt),
-		}
+		protoInfluencers[i] = toProtoInfluencer(inf, inf.Edges.Owner.ID)
 	}
 
 	return &ocsv1.ListInfluencersResponse{
-		Influencers: protoInfluencers,
-		NextPageToken: func() string {
-			if len(influencers) > 0 {
-				return influencers[len(influencers)-1].ID
-			}
-			return ""
-		}(),
+		Influencers:   protoInfluencers,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 // Post Management
 func (s *Server) SchedulePost(ctx context.Context, req *ocsv1.SchedulePostRequest) (*ocsv1.SchedulePostResponse, error) {
-	// Get the authenticated user's claims
-	claims, err := auth.GetUserFromContext(ctx)
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the influencer
-	influencer, err := s.client.Influencer.Get(ctx, req.InfluencerId)
+	// Check if the user has permission to create posts for this influencer
+	influencer, level, err := s.authorizeInfluencer(ctx, principal, req.InfluencerId, levelDrafter)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, status.Error(codes.NotFound, "influencer not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get influencer: %v", err)
+		return nil, err
 	}
 
-	// Check if the user has permission to create posts for this influencer
-	if influencer.Edges.Owner.Auth0ID != claims.Subject && claims.Subject != "admin" {
-		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	// Drafters can only propose posts; a publisher has to approve them.
+	// Posts for a disconnected influencer wait until it is reconnected.
+	postStatus := "scheduled"
+	if !levelAtLeast(level, levelPublisher) {
+		postStatus = "draft"
+	} else if influencer.Status == "disconnected" {
+		postStatus = "paused"
 	}
 
 	// Create the post
@@ -336,6 +433,7 @@ func (s *Server) SchedulePost(ctx context.Context, req *ocsv1.SchedulePostReques
 		SetID(uuid.New().String()).
 		SetContent(req.Content).
 		SetScheduledTime(req.ScheduledTime.AsTime()).
+		SetStatus(postStatus).
 		SetInfluencer(influencer).
 		Save(ctx)
 	if err != nil {
@@ -343,21 +441,13 @@ func (s *Server) SchedulePost(ctx context.Context, req *ocsv1.SchedulePostReques
 	}
 
 	return &ocsv1.SchedulePostResponse{
-		Post: &ocsv1.Post{
-			Id:            post.ID,
-			InfluencerId:  post.Edges.Influencer.ID,
-			Content:       post.Content,
-			ScheduledTime: timestamppb.New(post.ScheduledTime),
-			Status:        post.Status,
-			CreatedAt:     timestamppb.New(post.CreatedAt),
-			UpdatedAt:     timestamppb.New(post.UpdatedAt),
-		},
+		Post: toProtoPost(post),
 	}, nil
 }
 
 func (s *Server) GetPost(ctx context.Context, req *ocsv1.GetPostRequest) (*ocsv1.GetPostResponse, error) {
-	// Get the authenticated user's claims
-	claims, err := auth.GetUserFromContext(ctx)
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -372,80 +462,194 @@ func (s *Server) GetPost(ctx context.Context, req *ocsv1.GetPostRequest) (*ocsv1
 	}
 
 	// Check if the user has permission to view this post
-	if post.Edges.Influencer.Edges.Owner.Auth0ID != claims.Subject && claims.Subject != "admin" {
-		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	if _, _, err := s.authorizeInfluencer(ctx, principal, post.InfluencerID, levelViewer); err != nil {
+		return nil, err
 	}
 
 	return &ocsv1.GetPostResponse{
-		Post: &ocsv1.Post{
-			Id:            post.ID,
-			InfluencerId:  post.Edges.Influencer.ID,
-			Content:       post.Content,
-			ScheduledTime: timestamppb.New(post.ScheduledTime),
-			Status:        post.Status,
-			CreatedAt:     timestamppb.New(post.CreatedAt),
-			UpdatedAt:     timestamppb.New(post.UpdatedAt),
-		},
+		Post: toProtoPost(post),
 	}, nil
 }
 
-func (s *Server) ListPosts(ctx context.Context, req *ocsv1.ListPostsRequest) (*ocsv1.ListPostsResponse, error) {
-	// Get the authenticated user's claims
-	claims, err := auth.GetUserFromContext(ctx)
+func (s *Server) UpdatePost(ctx context.Context, req *ocsv1.UpdatePostRequest) (*ocsv1.UpdatePostResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the influencer
-	influencer, err := s.client.Influencer.Get(ctx, req.InfluencerId)
+	// Get the post
+	p, err := s.client.Post.Get(ctx, req.Id)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, status.Error(codes.NotFound, "influencer not found")
+			return nil, status.Error(codes.NotFound, "post not found")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to get influencer: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+
+	// Drafters can change drafts; changing anything else takes a publisher
+	_, level, err := s.authorizeInfluencer(ctx, principal, p.InfluencerID, levelDrafter)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != "draft" && !levelAtLeast(level, levelPublisher) {
+		return nil, status.Error(codes.PermissionDenied, "only publishers can change posts that are not drafts")
+	}
+	if p.Status == "posted" {
+		return nil, status.Error(codes.FailedPrecondition, "post has already been published")
+	}
+
+	// Work out which fields to change
+	paths, err := updatePaths(req, req.UpdateMask, "content", "scheduled_time")
+	if err != nil {
+		return nil, err
+	}
+	if paths["scheduled_time"] && req.ScheduledTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_time can't be cleared")
+	}
+
+	// Reject the write if the post changed since the caller read it
+	if err := checkETag(req.Etag, p.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	// Update the post
+	update := p.Update().
+		Where(post.UpdatedAtEQ(p.UpdatedAt))
+	if paths["content"] {
+		update.SetContent(req.Content)
+	}
+	if paths["scheduled_time"] {
+		update.SetScheduledTime(req.ScheduledTime.AsTime())
+	}
+
+	p, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errConcurrentUpdate("post", req.Etag)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update post: %v", err)
+	}
+
+	return &ocsv1.UpdatePostResponse{
+		Post: toProtoPost(p),
+	}, nil
+}
+
+func (s *Server) ApprovePost(ctx context.Context, req *ocsv1.ApprovePostRequest) (*ocsv1.ApprovePostResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the post
+	p, err := s.client.Post.Get(ctx, req.Id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+
+	// Check if the user has permission to publish for this influencer
+	influencer, _, err := s.authorizeInfluencer(ctx, principal, p.InfluencerID, levelPublisher)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Status != "draft" {
+		return nil, status.Errorf(codes.FailedPrecondition, "post is %s, not a draft", p.Status)
+	}
+
+	// Make sure the draft that was reviewed is the one being approved
+	if err := checkETag(req.Etag, p.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	postStatus := "scheduled"
+	if influencer.Status == "disconnected" {
+		postStatus = "paused"
+	}
+	p, err = p.Update().
+		Where(post.UpdatedAtEQ(p.UpdatedAt)).
+		SetStatus(postStatus).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errConcurrentUpdate("post", req.Etag)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to approve post: %v", err)
+	}
+
+	return &ocsv1.ApprovePostResponse{
+		Post: toProtoPost(p),
+	}, nil
+}
+
+func (s *Server) ListPosts(ctx context.Context, req *ocsv1.ListPostsRequest) (*ocsv1.ListPostsResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Check if the user has permission to view posts for this influencer
-	if influencer.Edges.Owner.Auth0ID != claims.Subject && claims.Subject != "admin" {
-		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	influencer, _, err := s.authorizeInfluencer(ctx, principal, req.InfluencerId, levelViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Posts are only listed for influencers in the active organization,
+	// unless the caller was granted access to the influencer directly
+	org, err := s.activeOrganization(ctx, principal, roleViewer)
+	if err != nil {
+		return nil, err
+	}
+	if influencer.OrganizationID != org.OrganizationID && !principal.IsAdmin() {
+		grant, err := s.activeGrant(ctx, principal, influencer.ID)
+		if err != nil {
+			return nil, err
+		}
+		if grant == nil {
+			return nil, status.Error(codes.NotFound, "influencer not found in the active organization")
+		}
 	}
 
 	// Build the query
 	query := s.client.Post.Query().Where(post.InfluencerID(influencer.ID))
 
-	// Apply pagination
-	if req.PageSize > 0 {
-		query = query.Limit(int(req.PageSize))
+	// Apply the filter and order
+	filter, err := postListing.filter(req)
+	if err != nil {
+		return nil, err
 	}
-	if req.PageToken != "" {
-		query = query.Where(post.IDGT(req.PageToken))
+	order, err := postListing.order(req)
+	if err != nil {
+		return nil, err
 	}
+	query = query.Where(filter)
 
-	posts, err := query.WithInfluencer().All(ctx)
+	// Apply pagination
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, order...)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(page.where).Order(page.order).Limit(page.limit())
+
+	posts, err := query.All(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list posts: %v", err)
 	}
+	posts, nextPageToken := page.trim(posts)
 
 	protoPosts := make([]*ocsv1.Post, len(posts))
 	for i, p := range posts {
-		protoPosts[i] = &ocsv1.Post{
-			Id:            p.ID,
-			InfluencerId:  p.Edges.Influencer.ID,
-			Content:       p.Content,
-			ScheduledTime: timestamppb.New(p.ScheduledTime),
-			Status:        p.Status,
-			CreatedAt:     timestamppb.New(p.CreatedAt),
-			UpdatedAt:     timestamppb.New(p.UpdatedAt),
-		}
+		protoPosts[i] = toProtoPost(p)
 	}
 
 	return &ocsv1.ListPostsResponse{
-		Posts: protoPosts,
-		NextPageToken: func() string {
-			if len(posts) > 0 {
-				return posts[len(posts)-1].ID
-			}
-			return ""
-		}(),
+		Posts:         protoPosts,
+		NextPageToken: nextPageToken,
 	}, nil
 }
@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/apikey"
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/serviceaccount"
+	"github.com/WuPinYi/SocialForge/internal/ent/user"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// Service Account Management
+func (s *Server) CreateServiceAccount(ctx context.Context, req *ocsv1.CreateServiceAccountRequest) (*ocsv1.CreateServiceAccountResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// API keys cannot be used to mint further API keys
+	if principal.IsServiceAccount() {
+		return nil, status.Error(codes.PermissionDenied, "service accounts cannot manage API keys")
+	}
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := validateScopes(principal, req.Scopes); err != nil {
+		return nil, err
+	}
+
+	// A certificate identity authenticates whoever holds a matching
+	// certificate from the client CA, so only admin can bind one
+	if req.ClientCertIdentity != "" && !principal.IsAdmin() {
+		return nil, status.Error(codes.PermissionDenied, "only admin can bind client certificate identities")
+	}
+
+	key, err := apikey.Generate()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate API key: %v", err)
+	}
+
+	create := s.client.ServiceAccount.Create().
+		SetID(uuid.New().String()).
+		SetName(req.Name).
+		SetScopes(req.Scopes).
+		SetKeyPrefix(key.Prefix).
+		SetKeyHash(key.Hash).
+		SetOwnerID(principal.UserID)
+	if req.ExpiresAt != nil {
+		create.SetExpiresAt(req.ExpiresAt.AsTime())
+	}
+	if req.ClientCertIdentity != "" {
+		create.SetClientCertIdentity(req.ClientCertIdentity)
+	}
+
+	sa, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) && req.ClientCertIdentity != "" {
+			return nil, status.Error(codes.AlreadyExists, "client certificate identity is already bound to a service account")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create service account: %v", err)
+	}
+
+	return &ocsv1.CreateServiceAccountResponse{
+		ServiceAccount: toProtoServiceAccount(sa, principal.UserID),
+		ApiKey:         key.Value,
+	}, nil
+}
+
+func (s *Server) ListServiceAccounts(ctx context.Context, req *ocsv1.ListServiceAccountsRequest) (*ocsv1.ListServiceAccountsResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if principal.IsServiceAccount() {
+		return nil, status.Error(codes.PermissionDenied, "service accounts cannot manage API keys")
+	}
+
+	// Build the query; admin can see every service account
+	query := s.client.ServiceAccount.Query()
+	if !principal.IsAdmin() {
+		query = query.Where(serviceaccount.HasOwnerWith(user.Auth0IDEQ(principal.Subject)))
+	}
+
+	// Apply pagination, oldest first
+	page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, serviceAccountsByCreation...)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(page.where).Order(page.order).Limit(page.limit())
+
+	accounts, err := query.WithOwner().All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list service accounts: %v", err)
+	}
+	accounts, nextPageToken := page.trim(accounts)
+
+	protoAccounts := make([]*ocsv1.ServiceAccount, len(accounts))
+	for i, sa := range accounts {
+		protoAccounts[i] = toProtoServiceAccount(sa, sa.Edges.Owner.ID)
+	}
+
+	return &ocsv1.ListServiceAccountsResponse{
+		ServiceAccounts: protoAccounts,
+		NextPageToken:   nextPageToken,
+	}, nil
+}
+
+func (s *Server) RotateServiceAccountKey(ctx context.Context, req *ocsv1.RotateServiceAccountKeyRequest) (*ocsv1.RotateServiceAccountKeyResponse, error) {
+	sa, err := s.getManagedServiceAccount(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoking is final; rotating must not bring a revoked account back
+	if sa.RevokedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "service account is revoked")
+	}
+
+	key, err := apikey.Generate()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate API key: %v", err)
+	}
+
+	// Replacing the hash invalidates the previous key immediately. The
+	// account may have been revoked since it was read.
+	update := s.client.ServiceAccount.UpdateOneID(sa.ID).
+		Where(serviceaccount.RevokedAtIsNil()).
+		SetKeyPrefix(key.Prefix).
+		SetKeyHash(key.Hash).
+		ClearLastUsedAt()
+	if req.ExpiresAt != nil {
+		update.SetExpiresAt(req.ExpiresAt.AsTime())
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.FailedPrecondition, "service account is revoked")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to rotate API key: %v", err)
+	}
+
+	return &ocsv1.RotateServiceAccountKeyResponse{
+		ServiceAccount: toProtoServiceAccount(updated, sa.Edges.Owner.ID),
+		ApiKey:         key.Value,
+	}, nil
+}
+
+func (s *Server) RevokeServiceAccountKey(ctx context.Context, req *ocsv1.RevokeServiceAccountKeyRequest) (*ocsv1.RevokeServiceAccountKeyResponse, error) {
+	sa, err := s.getManagedServiceAccount(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if sa.RevokedAt != nil {
+		return &ocsv1.RevokeServiceAccountKeyResponse{
+			ServiceAccount: toProtoServiceAccount(sa, sa.Edges.Owner.ID),
+		}, nil
+	}
+
+	updated, err := sa.Update().
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke API key: %v", err)
+	}
+
+	return &ocsv1.RevokeServiceAccountKeyResponse{
+		ServiceAccount: toProtoServiceAccount(updated, sa.Edges.Owner.ID),
+	}, nil
+}
+
+// getManagedServiceAccount loads a service account the caller is allowed to manage
+func (s *Server) getManagedServiceAccount(ctx context.Context, id string) (*ent.ServiceAccount, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if principal.IsServiceAccount() {
+		return nil, status.Error(codes.PermissionDenied, "service accounts cannot manage API keys")
+	}
+
+	sa, err := s.client.ServiceAccount.Query().
+		Where(serviceaccount.ID(id)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "service account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get service account: %v", err)
+	}
+
+	// Check if the user has permission to manage this service account
+	if sa.Edges.Owner.Auth0ID != principal.Subject && !principal.IsAdmin() {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	return sa, nil
+}
+
+// validateScopes checks that scopes are known and that the caller may grant them
+func validateScopes(principal *auth.Principal, scopes []string) error {
+	if len(scopes) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(auth.Scopes, scope) {
+			return status.Errorf(codes.InvalidArgument, "unknown scope %q", scope)
+		}
+		if scope == auth.ScopeAdmin && !principal.IsAdmin() {
+			return status.Error(codes.PermissionDenied, "only admin can grant the admin scope")
+		}
+	}
+	return nil
+}
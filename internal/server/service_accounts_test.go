@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+func TestRotateServiceAccountKeyRevoked(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	ctx := context.Background()
+	s := NewServer(client)
+
+	alice := client.User.Create().SetID("user-alice").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	aliceCtx := auth.NewContext(ctx, &auth.Principal{Kind: auth.PrincipalUser, Subject: alice.Auth0ID, UserID: alice.ID})
+
+	created, err := s.CreateServiceAccount(aliceCtx, &ocsv1.CreateServiceAccountRequest{Name: "importer", Scopes: []string{auth.ScopeRead}})
+	if err != nil {
+		t.Fatalf("CreateServiceAccount: %v", err)
+	}
+	id := created.ServiceAccount.Id
+
+	rotated, err := s.RotateServiceAccountKey(aliceCtx, &ocsv1.RotateServiceAccountKeyRequest{Id: id})
+	if err != nil {
+		t.Fatalf("RotateServiceAccountKey: %v", err)
+	}
+	if rotated.ApiKey == "" || rotated.ApiKey == created.ApiKey {
+		t.Errorf("rotated key = %q, want a new key", rotated.ApiKey)
+	}
+
+	if _, err := s.RevokeServiceAccountKey(aliceCtx, &ocsv1.RevokeServiceAccountKeyRequest{Id: id}); err != nil {
+		t.Fatalf("RevokeServiceAccountKey: %v", err)
+	}
+	revoked := client.ServiceAccount.GetX(ctx, id)
+
+	_, err = s.RotateServiceAccountKey(aliceCtx, &ocsv1.RotateServiceAccountKeyRequest{Id: id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("rotating a revoked account: %v, want FailedPrecondition", err)
+	}
+	sa := client.ServiceAccount.GetX(ctx, id)
+	if sa.RevokedAt == nil || sa.KeyHash != revoked.KeyHash {
+		t.Errorf("service account was changed: revoked at %v, key changed %v", sa.RevokedAt, sa.KeyHash != revoked.KeyHash)
+	}
+}
@@ -0,0 +1,350 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/ent/predicate"
+	"github.com/WuPinYi/SocialForge/internal/softdelete"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// Trash resource types
+const (
+	trashInfluencers = "influencer"
+	trashPosts       = "post"
+)
+
+// Trash
+func (s *Server) DeleteInfluencer(ctx context.Context, req *ocsv1.DeleteInfluencerRequest) (*ocsv1.DeleteInfluencerResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleting an influencer takes a manager
+	inf, _, err := s.authorizeInfluencer(ctx, principal, req.Id, levelManager)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkETag(req.Etag, inf.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	// The soft delete hook moves the influencer's posts to the trash along
+	// with it, so both happen in one transaction
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	n, err := tx.Influencer.Delete().
+		Where(
+			influencer.ID(inf.ID),
+			influencer.UpdatedAtEQ(inf.UpdatedAt),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete influencer: %v", err)
+	}
+	if n == 0 {
+		return nil, errConcurrentUpdate("influencer", req.Etag)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete influencer: %v", err)
+	}
+
+	deleted, err := s.client.Influencer.Get(softdelete.Skip(ctx), inf.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get influencer: %v", err)
+	}
+
+	return &ocsv1.DeleteInfluencerResponse{
+		Influencer: toProtoInfluencer(deleted, inf.Edges.Owner.ID),
+	}, nil
+}
+
+func (s *Server) RestoreInfluencer(ctx context.Context, req *ocsv1.RestoreInfluencerRequest) (*ocsv1.RestoreInfluencerResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Look in the trash too
+	ctx = softdelete.Skip(ctx)
+	inf, _, err := s.authorizeInfluencer(ctx, principal, req.Id, levelManager)
+	if err != nil {
+		return nil, err
+	}
+	if inf.DeletedAt == nil {
+		return nil, status.Error(codes.FailedPrecondition, "influencer is not in the trash")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Restore the posts that were deleted along with the influencer, but
+	// not those deleted on their own before
+	err = restorePosts(ctx, tx,
+		post.InfluencerID(inf.ID),
+		post.DeletedAtEQ(*inf.DeletedAt),
+	)
+	if err != nil {
+		return nil, err
+	}
+	restored, err := tx.Influencer.UpdateOneID(inf.ID).
+		Where(influencer.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errConcurrentUpdate("influencer", "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to restore influencer: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore influencer: %v", err)
+	}
+
+	return &ocsv1.RestoreInfluencerResponse{
+		Influencer: toProtoInfluencer(restored, inf.Edges.Owner.ID),
+	}, nil
+}
+
+func (s *Server) DeletePost(ctx context.Context, req *ocsv1.DeletePostRequest) (*ocsv1.DeletePostResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the post
+	p, err := s.client.Post.Get(ctx, req.Id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+	if err := s.authorizePostChange(ctx, principal, p); err != nil {
+		return nil, err
+	}
+	if err := checkETag(req.Etag, p.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	n, err := s.client.Post.Delete().
+		Where(
+			post.ID(p.ID),
+			post.UpdatedAtEQ(p.UpdatedAt),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete post: %v", err)
+	}
+	if n == 0 {
+		return nil, errConcurrentUpdate("post", req.Etag)
+	}
+
+	deleted, err := s.client.Post.Get(softdelete.Skip(ctx), p.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+
+	return &ocsv1.DeletePostResponse{
+		Post: toProtoPost(deleted),
+	}, nil
+}
+
+func (s *Server) RestorePost(ctx context.Context, req *ocsv1.RestorePostRequest) (*ocsv1.RestorePostResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Look in the trash too
+	ctx = softdelete.Skip(ctx)
+	p, err := s.client.Post.Get(ctx, req.Id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+	if err := s.authorizePostChange(ctx, principal, p); err != nil {
+		return nil, err
+	}
+	if p.DeletedAt == nil {
+		return nil, status.Error(codes.FailedPrecondition, "post is not in the trash")
+	}
+	inf, err := s.client.Influencer.Get(ctx, p.InfluencerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get influencer: %v", err)
+	}
+	if inf.DeletedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "the post's influencer is in the trash; restore it first")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := restorePosts(ctx, tx, post.ID(p.ID), post.DeletedAtNotNil()); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore post: %v", err)
+	}
+
+	restored, err := s.client.Post.Get(ctx, p.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get post: %v", err)
+	}
+
+	return &ocsv1.RestorePostResponse{
+		Post: toProtoPost(restored),
+	}, nil
+}
+
+func (s *Server) ListTrash(ctx context.Context, req *ocsv1.ListTrashRequest) (*ocsv1.ListTrashResponse, error) {
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The trash holds what the caller could see before it was deleted
+	org, err := s.activeOrganization(ctx, principal, roleViewer)
+	if err != nil {
+		return nil, err
+	}
+	visible := visibleInfluencers(principal, org)
+	ctx = softdelete.Skip(ctx)
+
+	switch req.ResourceType {
+	case trashInfluencers:
+		page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, influencersByDeletion...)
+		if err != nil {
+			return nil, err
+		}
+		influencers, err := s.client.Influencer.Query().
+			Where(
+				influencer.DeletedAtNotNil(),
+				visible,
+			).
+			Where(page.where).
+			Order(page.order).
+			Limit(page.limit()).
+			WithOwner().
+			All(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list influencers: %v", err)
+		}
+		influencers, nextPageToken := page.trim(influencers)
+
+		protoInfluencers := make([]*ocsv1.Influencer, len(influencers))
+		for i, inf := range influencers {
+			protoInfluencers[i] = toProtoInfluencer(inf, inf.Edges.Owner.ID)
+		}
+		return &ocsv1.ListTrashResponse{
+			Influencers:   protoInfluencers,
+			NextPageToken: nextPageToken,
+		}, nil
+
+	case trashPosts:
+		// Posts of deleted influencers are listed with their influencer
+		page, err := newPage(s.pageTokens, req, req.PageSize, req.PageToken, postsByDeletion...)
+		if err != nil {
+			return nil, err
+		}
+		posts, err := s.client.Post.Query().
+			Where(
+				post.DeletedAtNotNil(),
+				post.HasInfluencerWith(visible, influencer.DeletedAtIsNil()),
+			).
+			Where(page.where).
+			Order(page.order).
+			Limit(page.limit()).
+			All(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list posts: %v", err)
+		}
+		posts, nextPageToken := page.trim(posts)
+
+		protoPosts := make([]*ocsv1.Post, len(posts))
+		for i, p := range posts {
+			protoPosts[i] = toProtoPost(p)
+		}
+		return &ocsv1.ListTrashResponse{
+			Posts:         protoPosts,
+			NextPageToken: nextPageToken,
+		}, nil
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown resource_type %q; use \"influencer\" or \"post\"", req.ResourceType)
+	}
+}
+
+// authorizePostChange checks that the caller may delete or restore a post:
+// drafters can change drafts, anything else takes a publisher
+func (s *Server) authorizePostChange(ctx context.Context, principal *auth.Principal, p *ent.Post) error {
+	_, level, err := s.authorizeInfluencer(ctx, principal, p.InfluencerID, levelDrafter)
+	if err != nil {
+		return err
+	}
+	if p.Status != "draft" && !levelAtLeast(level, levelPublisher) {
+		return status.Error(codes.PermissionDenied, "only publishers can change posts that are not drafts")
+	}
+	return nil
+}
+
+// restorePosts takes the matching posts out of the trash. Scheduled posts
+// whose time passed meanwhile come back as drafts rather than being
+// published right away.
+func restorePosts(ctx context.Context, tx *ent.Tx, where ...predicate.Post) error {
+	ids, err := tx.Post.Query().
+		Where(where...).
+		IDs(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to find posts: %v", err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	err = tx.Post.Update().
+		Where(post.IDIn(ids...)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to restore posts: %v", err)
+	}
+	err = tx.Post.Update().
+		Where(
+			post.IDIn(ids...),
+			post.Status("scheduled"),
+			post.ScheduledTimeLT(time.Now()),
+		).
+		SetStatus("draft").
+		Exec(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to restore posts: %v", err)
+	}
+	return nil
+}
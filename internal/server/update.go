@@ -0,0 +1,74 @@
+package server
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updatePaths returns the fields an Update RPC changes. With a mask these
+// are its paths, or all fields for "*"; without one they are the fields
+// that are set in the request, so a field can only be cleared through the
+// mask.
+func updatePaths(req proto.Message, mask *fieldmaskpb.FieldMask, fields ...string) (map[string]bool, error) {
+	paths := make(map[string]bool)
+	if len(mask.GetPaths()) == 0 {
+		r := req.ProtoReflect()
+		for _, name := range fields {
+			if r.Has(r.Descriptor().Fields().ByName(protoreflect.Name(name))) {
+				paths[name] = true
+			}
+		}
+		return paths, nil
+	}
+
+	for _, path := range mask.GetPaths() {
+		switch {
+		case path == "*" && len(mask.GetPaths()) == 1:
+			for _, name := range fields {
+				paths[name] = true
+			}
+		case slices.Contains(fields, path):
+			paths[path] = true
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask: %q can't be updated", path)
+		}
+	}
+	return paths, nil
+}
+
+// etag returns the entity tag of a resource last updated at updatedAt. The
+// time is cut to microseconds, the precision Postgres stores it with, so
+// the etag of a saved entity matches that of the same row read back.
+func etag(updatedAt time.Time) string {
+	return strconv.FormatInt(updatedAt.UnixMicro(), 36)
+}
+
+// errETagMismatch is returned when the caller's etag is stale. Retrying
+// the same write can't succeed, so it fails with FAILED_PRECONDITION.
+var errETagMismatch = status.Error(codes.FailedPrecondition, "etag mismatch; the resource was changed since it was read")
+
+// checkETag fails with FAILED_PRECONDITION if the caller passed an etag and
+// the resource has changed since
+func checkETag(want string, updatedAt time.Time) error {
+	if want != "" && want != etag(updatedAt) {
+		return errETagMismatch
+	}
+	return nil
+}
+
+// errConcurrentUpdate is returned when a conditional update lost the race
+// against another write. If the caller passed an etag, it no longer matches;
+// otherwise the write can be retried as is, so it fails with ABORTED.
+func errConcurrentUpdate(resource, want string) error {
+	if want != "" {
+		return errETagMismatch
+	}
+	return status.Errorf(codes.Aborted, "the %s was changed concurrently; read it again and retry", resource)
+}
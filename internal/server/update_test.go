@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+func TestUpdateInfluencerETag(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	ctx := context.Background()
+	s := NewServer(client)
+
+	alice := client.User.Create().SetID("user-alice").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	aliceCtx := auth.NewContext(ctx, &auth.Principal{Kind: auth.PrincipalUser, Subject: alice.Auth0ID, UserID: alice.ID})
+	inf := client.Influencer.Create().SetID("inf-1").SetName("Alice").SetPlatform("x").SetOwner(alice).SaveX(ctx)
+
+	got, err := s.GetInfluencer(aliceCtx, &ocsv1.GetInfluencerRequest{Id: inf.ID})
+	if err != nil {
+		t.Fatalf("GetInfluencer: %v", err)
+	}
+	read := got.Influencer.Etag
+
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"name"}}
+	updated, err := s.UpdateInfluencer(aliceCtx, &ocsv1.UpdateInfluencerRequest{Id: inf.ID, Name: "Alice B", UpdateMask: mask, Etag: read})
+	if err != nil {
+		t.Fatalf("UpdateInfluencer with the current etag: %v", err)
+	}
+	if updated.Influencer.Etag == read {
+		t.Error("etag didn't change with the update")
+	}
+
+	// A write based on the earlier read is rejected and changes nothing
+	_, err = s.UpdateInfluencer(aliceCtx, &ocsv1.UpdateInfluencerRequest{Id: inf.ID, Name: "Alice C", UpdateMask: mask, Etag: read})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("UpdateInfluencer with a stale etag: %v, want FailedPrecondition", err)
+	}
+	if name := client.Influencer.GetX(ctx, inf.ID).Name; name != "Alice B" {
+		t.Errorf("name = %q, want Alice B", name)
+	}
+
+	// Without an etag the last write wins
+	if _, err := s.UpdateInfluencer(aliceCtx, &ocsv1.UpdateInfluencerRequest{Id: inf.ID, Name: "Alice C", UpdateMask: mask}); err != nil {
+		t.Fatalf("UpdateInfluencer without an etag: %v", err)
+	}
+}
+
+func TestErrConcurrentUpdate(t *testing.T) {
+	if code := status.Code(errConcurrentUpdate("post", "")); code != codes.Aborted {
+		t.Errorf("without an etag: %v, want Aborted", code)
+	}
+	if code := status.Code(errConcurrentUpdate("post", "abc")); code != codes.FailedPrecondition {
+		t.Errorf("with an etag: %v, want FailedPrecondition", code)
+	}
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/postevents"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// visibilityTTL is how long WatchPosts relies on an influencer's visibility
+// before checking it again
+var visibilityTTL = time.Minute
+
+// Post Events
+func (s *Server) WatchPosts(req *ocsv1.WatchPostsRequest, stream grpc.ServerStreamingServer[ocsv1.WatchPostsResponse]) error {
+	ctx := stream.Context()
+
+	// Get the authenticated principal
+	principal, err := auth.GetPrincipalFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.postEvents == nil {
+		return status.Error(codes.FailedPrecondition, "post events are not configured")
+	}
+
+	org, err := s.activeOrganization(ctx, principal, roleViewer)
+	if err != nil {
+		return err
+	}
+
+	// Requested influencers must be visible to the caller. Without any, the
+	// visibility of each influencer is checked when its first event arrives.
+	visible := make(map[string]bool)
+	requested := make(map[string]bool)
+	for _, id := range req.InfluencerIds {
+		if _, _, err := s.authorizeInfluencer(ctx, principal, id, levelViewer); err != nil {
+			return err
+		}
+		visible[id] = true
+		requested[id] = true
+	}
+	checked := time.Now()
+	statuses := make(map[string]bool)
+	for _, st := range req.Statuses {
+		statuses[st] = true
+	}
+
+	sub, err := s.postEvents.Subscribe(req.Cursor)
+	switch {
+	case errors.Is(err, postevents.ErrCursorExpired):
+		return status.Error(codes.OutOfRange, "cursor expired or was issued by another server; list the posts again and watch without a cursor")
+	case errors.Is(err, postevents.ErrInvalidCursor):
+		return status.Error(codes.InvalidArgument, "invalid cursor")
+	case err != nil:
+		return status.Errorf(codes.Internal, "failed to watch posts: %v", err)
+	}
+	defer sub.Close()
+
+	for {
+		e, err := sub.Next(ctx)
+		if err != nil {
+			if errors.Is(err, postevents.ErrTooSlow) {
+				return status.Error(codes.Aborted, "the stream fell too far behind; resume from the last cursor")
+			}
+			return status.FromContextError(err).Err()
+		}
+
+		if len(statuses) > 0 && !statuses[e.Post.Status] {
+			continue
+		}
+		if len(requested) > 0 && !requested[e.Post.InfluencerID] {
+			continue
+		}
+
+		// Access can be revoked while the stream is open, so what was
+		// checked is forgotten after a while and checked again
+		if time.Since(checked) > visibilityTTL {
+			if org, err = s.activeOrganization(ctx, principal, roleViewer); err != nil {
+				return err
+			}
+			clear(visible)
+			checked = time.Now()
+		}
+		ok, known := visible[e.Post.InfluencerID]
+		if !known {
+			if ok, err = s.watchable(ctx, principal, org, e.Post.InfluencerID, requested[e.Post.InfluencerID]); err != nil {
+				return err
+			}
+			visible[e.Post.InfluencerID] = ok
+		}
+		if !ok {
+			continue
+		}
+
+		err = stream.Send(&ocsv1.WatchPostsResponse{
+			Event: &ocsv1.PostEvent{
+				Cursor: e.Cursor,
+				Kind:   e.Kind,
+				Post:   toProtoPost(e.Post),
+				Error:  e.Error,
+				Time:   timestamppb.New(e.Time),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// watchable reports whether the caller can watch the posts of an
+// influencer. A requested influencer is checked like when the stream
+// started; otherwise the influencer must be one of those ListCalendar shows.
+func (s *Server) watchable(ctx context.Context, principal *auth.Principal, org *orgAccess, id string, requested bool) (bool, error) {
+	if requested {
+		inf, err := s.client.Influencer.Query().
+			Where(influencer.ID(id)).
+			WithOwner().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return false, nil
+			}
+			return false, status.Errorf(codes.Internal, "failed to get influencer: %v", err)
+		}
+		level, err := s.influencerAccess(ctx, principal, inf)
+		if err != nil {
+			return false, err
+		}
+		return levelAtLeast(level, levelViewer), nil
+	}
+
+	n, err := s.client.Influencer.Query().
+		Where(
+			influencer.ID(id),
+			visibleInfluencers(principal, org),
+		).
+		Count(ctx)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to check influencer access: %v", err)
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc"
+
+	"github.com/WuPinYi/SocialForge/internal/auth"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	"github.com/WuPinYi/SocialForge/internal/postevents"
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// watchStream collects the events WatchPosts sends
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *ocsv1.PostEvent
+}
+
+func (s *watchStream) Context() context.Context { return s.ctx }
+
+func (s *watchStream) Send(resp *ocsv1.WatchPostsResponse) error {
+	s.events <- resp.Event
+	return nil
+}
+
+func TestWatchPostsRevokedAccess(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	ctx := context.Background()
+	broker := postevents.NewBroker()
+	s := NewServer(client, WithPostEvents(broker))
+
+	// Check access on every event
+	defer func(ttl time.Duration) { visibilityTTL = ttl }(visibilityTTL)
+	visibilityTTL = 0
+
+	alice := client.User.Create().SetID("user-alice").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	bob := client.User.Create().SetID("user-bob").SetName("Bob").SetAuth0ID("auth0|bob").SaveX(ctx)
+	shared := client.Influencer.Create().SetID("inf-shared").SetName("Alice").SetPlatform("x").SetOwner(alice).SaveX(ctx)
+	own := client.Influencer.Create().SetID("inf-own").SetName("Bob").SetPlatform("x").SetOwner(bob).SaveX(ctx)
+	grant := client.AccessGrant.Create().
+		SetID("grant-1").
+		SetInfluencer(shared).
+		SetUser(bob).
+		SetGrantedBy(alice.ID).
+		SaveX(ctx)
+
+	bobCtx, cancel := context.WithCancel(auth.NewContext(ctx, &auth.Principal{Kind: auth.PrincipalUser, Subject: bob.Auth0ID, UserID: bob.ID}))
+	defer cancel()
+	stream := &watchStream{ctx: bobCtx, events: make(chan *ocsv1.PostEvent, 100)}
+	done := make(chan error, 1)
+	go func() { done <- s.WatchPosts(&ocsv1.WatchPostsRequest{}, stream) }()
+
+	publish := func(id string, inf *ent.Influencer) {
+		broker.Publish(postevents.Event{
+			Kind: postevents.KindCreated,
+			Post: &ent.Post{ID: id, InfluencerID: inf.ID, Status: "scheduled"},
+			Time: time.Now(),
+		})
+	}
+	// next returns the next event other than the pings sent until the
+	// stream was subscribed
+	next := func() string {
+		t.Helper()
+		for {
+			select {
+			case e := <-stream.events:
+				if e.Post.Id != "ping" {
+					return e.Post.Id
+				}
+			case err := <-done:
+				t.Fatalf("WatchPosts returned: %v", err)
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for an event")
+			}
+		}
+	}
+
+	// Wait until the stream is subscribed
+	for subscribed := false; !subscribed; {
+		publish("ping", own)
+		select {
+		case <-stream.events:
+			subscribed = true
+		case err := <-done:
+			t.Fatalf("WatchPosts returned: %v", err)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	publish("post-1", shared)
+	if got := next(); got != "post-1" {
+		t.Fatalf("got event for %s, want post-1", got)
+	}
+
+	// Once the grant is gone, the shared influencer's posts aren't sent
+	client.AccessGrant.DeleteOne(grant).ExecX(ctx)
+	publish("post-2", shared)
+	publish("post-3", own)
+	if got := next(); got != "post-3" {
+		t.Errorf("got event for %s, want post-3", got)
+	}
+}
@@ -0,0 +1,89 @@
+package softdelete
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+)
+
+type skipKey struct{}
+
+// Skip returns a context in which queries see soft-deleted influencers and
+// posts and deletes remove them for good
+func Skip(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipKey{}, true)
+}
+
+func skipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipKey{}).(bool)
+	return skip
+}
+
+// Interceptor returns an ent interceptor that hides soft-deleted influencers
+// and posts from every query, including edge traversals and eager loading
+func Interceptor() ent.Interceptor {
+	return ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		if skipped(ctx) {
+			return nil
+		}
+		switch q := q.(type) {
+		case *ent.InfluencerQuery:
+			q.Where(influencer.DeletedAtIsNil())
+		case *ent.PostQuery:
+			q.Where(post.DeletedAtIsNil())
+		}
+		return nil
+	})
+}
+
+// Hook returns an ent hook that turns deletes of influencers and posts into
+// setting their deleted_at. The posts of a deleted influencer are deleted
+// with it at the same time, which is how restoring the influencer tells
+// them from posts that were deleted on their own. The hook must be
+// registered before any hook that should only see the resulting update.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if skipped(ctx) || !m.Op().Is(ent.OpDelete|ent.OpDeleteOne) {
+				return next.Mutate(ctx, m)
+			}
+
+			// Postgres keeps microseconds; cut them here so the time
+			// compares equal to the one read back
+			now := time.Now().Truncate(time.Microsecond)
+			switch m := m.(type) {
+			case *ent.InfluencerMutation:
+				ids, err := m.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				err = m.Client().Post.Update().
+					Where(
+						post.InfluencerIDIn(ids...),
+						post.DeletedAtIsNil(),
+					).
+					SetDeletedAt(now).
+					Exec(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				m.SetOp(ent.OpUpdate)
+				m.SetDeletedAt(now)
+				m.WhereP(sql.FieldIsNull(influencer.FieldDeletedAt))
+				return m.Client().Mutate(ctx, m)
+			case *ent.PostMutation:
+				m.SetOp(ent.OpUpdate)
+				m.SetDeletedAt(now)
+				m.WhereP(sql.FieldIsNull(post.FieldDeletedAt))
+				return m.Client().Mutate(ctx, m)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
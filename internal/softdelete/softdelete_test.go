@@ -0,0 +1,69 @@
+package softdelete
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+)
+
+func TestSoftDelete(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	client.Use(Hook())
+	client.Intercept(Interceptor())
+	ctx := context.Background()
+
+	owner := client.User.Create().SetID("user-1").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	inf := client.Influencer.Create().SetID("inf-1").SetName("Alice").SetPlatform("x").SetOwner(owner).SaveX(ctx)
+	for _, id := range []string{"post-1", "post-2"} {
+		client.Post.Create().
+			SetID(id).
+			SetInfluencer(inf).
+			SetContent("Hello").
+			SetScheduledTime(time.Now().Add(time.Hour)).
+			ExecX(ctx)
+	}
+
+	// A deleted post is hidden, including from edge traversals and eager
+	// loading
+	client.Post.DeleteOneID("post-1").ExecX(ctx)
+	if n := client.Post.Query().CountX(ctx); n != 1 {
+		t.Errorf("got %d posts, want 1", n)
+	}
+	if n := inf.QueryPosts().CountX(ctx); n != 1 {
+		t.Errorf("got %d posts of the influencer, want 1", n)
+	}
+	loaded := client.Influencer.Query().WithPosts().OnlyX(ctx)
+	if len(loaded.Edges.Posts) != 1 {
+		t.Errorf("got %d eager-loaded posts, want 1", len(loaded.Edges.Posts))
+	}
+
+	// Deleting the influencer deletes its remaining posts at the same time
+	client.Influencer.DeleteOneID(inf.ID).ExecX(ctx)
+	if n := client.Influencer.Query().CountX(ctx); n != 0 {
+		t.Errorf("got %d influencers, want 0", n)
+	}
+
+	posts := client.Post.Query().Order(ent.Asc(post.FieldID)).AllX(Skip(ctx))
+	deleted := client.Influencer.GetX(Skip(ctx), inf.ID)
+	if len(posts) != 2 || deleted.DeletedAt == nil {
+		t.Fatalf("got %d posts and influencer deleted at %v, want them kept", len(posts), deleted.DeletedAt)
+	}
+	if !posts[1].DeletedAt.Equal(*deleted.DeletedAt) || posts[0].DeletedAt.Equal(*deleted.DeletedAt) {
+		t.Errorf("got posts deleted at %v and %v, want only post-2 deleted with the influencer at %v",
+			posts[0].DeletedAt, posts[1].DeletedAt, deleted.DeletedAt)
+	}
+
+	// Skipping deletes them for good
+	client.Post.Delete().ExecX(Skip(ctx))
+	if n := client.Post.Query().CountX(Skip(ctx)); n != 0 {
+		t.Errorf("got %d posts after a hard delete, want 0", n)
+	}
+}
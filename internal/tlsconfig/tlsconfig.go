@@ -0,0 +1,176 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// reloadInterval is how often the files are checked for changes
+const reloadInterval = 30 * time.Second
+
+// Config holds the TLS files
+type Config struct {
+	// CertFile is the PEM certificate chain served to clients
+	CertFile string
+	// KeyFile is the PEM private key of the certificate
+	KeyFile string
+	// ClientCAFile is the PEM bundle client certificates are verified
+	// against. Without it client certificates are not requested.
+	ClientCAFile string
+	// RequireClientCert rejects clients without a certificate rather than
+	// verifying it only when presented
+	RequireClientCert bool
+}
+
+// Reloader serves the certificate and client CAs from the configured files
+// and picks up changes to them without a restart, so certificates can be
+// rotated in place. A failed reload keeps the previous files in use.
+type Reloader struct {
+	config *Config
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+// NewReloader loads the configured files
+func NewReloader(config *Config) (*Reloader, error) {
+	r := &Reloader{config: config}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Start checks the files for changes until ctx is done
+func (r *Reloader) Start(ctx context.Context) {
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			if err := r.load(); err != nil {
+				log.Printf("Error reloading TLS certificates: %v", err)
+				continue
+			}
+			log.Printf("Reloaded TLS certificates")
+		}
+	}
+}
+
+// TLSConfig returns a server configuration that always uses the latest
+// certificate. With clientAuth set, client certificates are verified
+// against the client CAs as configured; otherwise they aren't requested.
+// nextProtos must list every ALPN protocol served, e.g. "h2" for gRPC.
+func (r *Reloader) TLSConfig(clientAuth bool, nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   nextProtos,
+				Certificates: []tls.Certificate{*r.cert},
+			}
+			if clientAuth && r.clientCAs != nil {
+				cfg.ClientCAs = r.clientCAs
+				cfg.ClientAuth = tls.VerifyClientCertIfGiven
+				if r.config.RequireClientCert {
+					cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// load reads all files and swaps them in at once
+func (r *Reloader) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, path := range r.files() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		modTimes[path] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate: %v", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.config.ClientCAFile != "" {
+		pem, err := os.ReadFile(r.config.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CAs: %v", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in %s", r.config.ClientCAFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+	return nil
+}
+
+// changed reports whether any of the files was modified since the last load
+func (r *Reloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, path := range r.files() {
+		info, err := os.Stat(path)
+		if err != nil {
+			// Files are often replaced by renaming; try again next time
+			continue
+		}
+		if !info.ModTime().Equal(r.modTimes[path]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.config.CertFile, r.config.KeyFile}
+	if r.config.ClientCAFile != "" {
+		files = append(files, r.config.ClientCAFile)
+	}
+	return files
+}
+
+// Describe returns a short description of the client authentication mode
+// for logging
+func (c *Config) Describe() string {
+	switch {
+	case c.RequireClientCert:
+		return "TLS with required client certificates"
+	case c.ClientCAFile != "":
+		return "TLS with optional client certificates"
+	default:
+		return "TLS"
+	}
+}
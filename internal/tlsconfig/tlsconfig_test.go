@@ -0,0 +1,129 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCert writes a self-signed certificate with the given serial number
+// and its key to the config's files
+func writeCert(t *testing.T, config *Config, serial int64) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(config.CertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(config.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// touch sets the modification time of the files, as file systems with a
+// coarse clock might not tell two writes in a row apart
+func touch(t *testing.T, modTime time.Time, paths ...string) {
+	t.Helper()
+	for _, path := range paths {
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// servedSerial returns the serial number of the certificate served to new
+// connections
+func servedSerial(t *testing.T, r *Reloader) int64 {
+	t.Helper()
+	cfg, err := r.TLSConfig(false).GetConfigForClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(cfg.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert.SerialNumber.Int64()
+}
+
+func TestReloaderReloadsChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	config := &Config{
+		CertFile: filepath.Join(dir, "tls.crt"),
+		KeyFile:  filepath.Join(dir, "tls.key"),
+	}
+	start := time.Now().Add(-time.Hour)
+	writeCert(t, config, 1)
+	touch(t, start, config.CertFile, config.KeyFile)
+
+	r, err := NewReloader(config)
+	if err != nil {
+		t.Fatalf("NewReloader: %v", err)
+	}
+	if r.changed() {
+		t.Error("changed right after loading")
+	}
+	if got := servedSerial(t, r); got != 1 {
+		t.Fatalf("serial = %d, want 1", got)
+	}
+
+	// A rotated certificate is picked up once its files' times change
+	writeCert(t, config, 2)
+	touch(t, start, config.CertFile, config.KeyFile)
+	if r.changed() {
+		t.Error("changed without a new modification time")
+	}
+	touch(t, start.Add(time.Minute), config.CertFile, config.KeyFile)
+	if !r.changed() {
+		t.Fatal("not changed after a new modification time")
+	}
+	if err := r.load(); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if got := servedSerial(t, r); got != 2 {
+		t.Errorf("serial = %d, want 2", got)
+	}
+	if r.changed() {
+		t.Error("changed right after reloading")
+	}
+
+	// A broken certificate keeps the previous one in use
+	if err := os.WriteFile(config.CertFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	touch(t, start.Add(2*time.Minute), config.CertFile)
+	if !r.changed() {
+		t.Fatal("not changed after writing a broken certificate")
+	}
+	if err := r.load(); err == nil {
+		t.Fatal("loading a broken certificate succeeded")
+	}
+	if got := servedSerial(t, r); got != 2 {
+		t.Errorf("serial after a failed reload = %d, want 2", got)
+	}
+}
@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	entgo "entgo.io/ent"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+)
+
+// Span attributes of ent operations
+const (
+	entTypeKey = attribute.Key("ent.type")
+	entOpKey   = attribute.Key("ent.op")
+)
+
+// Interceptor returns an ent interceptor that records a span for every
+// query, named after the entity type and operation, e.g. "Post.All"
+func Interceptor() ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			typ, op := "unknown", "unknown"
+			if qc := entgo.QueryFromContext(ctx); qc != nil {
+				typ, op = qc.Type, qc.Op
+			}
+			ctx, span := tracer.Start(ctx, typ+"."+op,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(entTypeKey.String(typ), entOpKey.String(op)),
+			)
+			v, err := next.Query(ctx, q)
+			End(span, err)
+			return v, err
+		})
+	})
+}
+
+// Hook returns an ent hook that records a span for every mutation, e.g.
+// "Post.Create". It should be registered before the other hooks, except
+// ScheduleHook, so that their queries and mutations are nested under it.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			op := strings.TrimPrefix(m.Op().String(), "Op")
+			ctx, span := tracer.Start(ctx, m.Type()+"."+op,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(entTypeKey.String(m.Type()), entOpKey.String(op)),
+			)
+			v, err := next.Mutate(ctx, m)
+			End(span, err)
+			return v, err
+		})
+	}
+}
+
+// ScheduleHook returns an ent hook that stores the trace context on posts
+// whenever their scheduled time is set, so that the post worker can link
+// publishing to the request that scheduled it. It must be registered
+// before Hook, which would otherwise give every mutation a span to store.
+func ScheduleHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			pm, ok := m.(*ent.PostMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			if _, ok := pm.ScheduledTime(); ok {
+				switch carrier := Inject(ctx); {
+				case carrier != nil:
+					pm.SetTraceContext(carrier)
+				case !pm.Op().Is(ent.OpCreate):
+					// Don't link to the request that scheduled it before
+					pm.ClearTraceContext()
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Package tracing sets up OpenTelemetry tracing and records the spans of
+// the server that the gRPC instrumentation doesn't: ent queries and
+// mutations, and publishing by the post worker.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// serviceName is reported unless OTEL_SERVICE_NAME says otherwise
+const serviceName = "socialforge"
+
+// Exporters spans can be sent to
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// tracer starts the spans of this package. It uses the global provider, so
+// spans started before Setup are dropped.
+var tracer = otel.Tracer("github.com/WuPinYi/SocialForge/internal/tracing")
+
+// Config configures exporting spans
+type Config struct {
+	// Exporter is ExporterOTLP or ExporterStdout
+	Exporter string
+	// Endpoint is the URL of the OTLP gRPC collector, e.g.
+	// http://localhost:4317. If empty, the OTEL_EXPORTER_OTLP_* variables
+	// apply.
+	Endpoint string
+	// SampleRatio is the fraction of new traces that are recorded. Traces
+	// started by a caller follow the caller's decision.
+	SampleRatio float64
+}
+
+// Setup installs the global tracer provider and the W3C trace context
+// propagator. With a nil cfg no spans are recorded, but trace context is
+// still passed on. The returned function flushes the remaining spans and
+// should be called on shutdown.
+func Setup(ctx context.Context, cfg *Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	if cfg == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe the service: %v", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+	switch cfg.Exporter {
+	case ExporterOTLP:
+		var exporterOpts []otlptracegrpc.Option
+		if cfg.Endpoint != "" {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithEndpointURL(cfg.Endpoint))
+		}
+		exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %v", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	case ExporterStdout:
+		// Spans are written as they end, since this is for watching
+		// locally
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %v", err)
+		}
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", cfg.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span of this service
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, opts...)
+}
+
+// End records err on the span, if any, and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject returns the trace context of ctx to be stored, or nil if ctx has
+// no span
+func Inject(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return carrier
+}
+
+// LinkTo returns a span option linking the new span to the one whose trace
+// context was stored by Inject. It does nothing if carrier holds none.
+func LinkTo(carrier map[string]string) trace.SpanStartOption {
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(carrier))
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return trace.WithLinks()
+	}
+	return trace.WithLinks(trace.Link{SpanContext: sc})
+}
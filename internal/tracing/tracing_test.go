@@ -0,0 +1,160 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+)
+
+var (
+	exporterOnce sync.Once
+	exporter     *tracetest.InMemoryExporter
+)
+
+// recordSpans installs a provider recording every span in memory and
+// returns its exporter, emptied. The package's tracer binds to the first
+// global provider, so all tests share one.
+func recordSpans(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exporterOnce.Do(func() {
+		if _, err := Setup(context.Background(), nil); err != nil {
+			t.Fatalf("Setup: %v", err)
+		}
+		exporter = tracetest.NewInMemoryExporter()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	})
+	exporter.Reset()
+	return exporter
+}
+
+// spanNames returns the names of the recorded spans in the order they ended
+func spanNames(exporter *tracetest.InMemoryExporter) []string {
+	var names []string
+	for _, s := range exporter.GetSpans() {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+func TestInjectLinkTo(t *testing.T) {
+	exporter := recordSpans(t)
+	ctx := context.Background()
+
+	if carrier := Inject(ctx); carrier != nil {
+		t.Errorf("Inject without a span = %v, want nil", carrier)
+	}
+
+	ctx, parent := StartSpan(ctx, "schedule")
+	carrier := Inject(ctx)
+	parent.End()
+	if carrier["traceparent"] == "" {
+		t.Fatalf("Inject = %v, want a traceparent", carrier)
+	}
+
+	// A later span in another trace links back to the stored one
+	_, publish := StartSpan(context.Background(), "publish", LinkTo(carrier))
+	publish.End()
+	_, orphan := StartSpan(context.Background(), "orphan", LinkTo(nil))
+	orphan.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("spans = %v, want schedule, publish and orphan", spanNames(exporter))
+	}
+	links := spans[1].Links
+	if len(links) != 1 || links[0].SpanContext.SpanID() != spans[0].SpanContext.SpanID() {
+		t.Errorf("publish links = %v, want the schedule span", links)
+	}
+	if spans[1].SpanContext.TraceID() == spans[0].SpanContext.TraceID() {
+		t.Error("publish joined the trace it links to")
+	}
+	if len(spans[2].Links) != 0 {
+		t.Errorf("orphan links = %v, want none", spans[2].Links)
+	}
+}
+
+func TestEnd(t *testing.T) {
+	exporter := recordSpans(t)
+
+	_, ok := StartSpan(context.Background(), "ok")
+	End(ok, nil)
+	_, failed := StartSpan(context.Background(), "failed")
+	End(failed, errors.New("platform unavailable"))
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("spans = %v, want ok and failed", spanNames(exporter))
+	}
+	if spans[0].Status.Code != codes.Unset {
+		t.Errorf("ok status = %v, want unset", spans[0].Status)
+	}
+	if spans[1].Status.Code != codes.Error || spans[1].Status.Description != "platform unavailable" {
+		t.Errorf("failed status = %v, want the error", spans[1].Status)
+	}
+	if len(spans[1].Events) != 1 || spans[1].Events[0].Name != "exception" {
+		t.Errorf("failed events = %v, want the error recorded", spans[1].Events)
+	}
+}
+
+func TestEntSpans(t *testing.T) {
+	exporter := recordSpans(t)
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	client.Use(ScheduleHook(), Hook())
+	client.Intercept(Interceptor())
+
+	ctx, request := StartSpan(context.Background(), "CreatePost")
+	owner := client.User.Create().SetID("user-1").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	inf := client.Influencer.Create().SetID("inf-1").SetName("Alice").SetPlatform("x").SetOwner(owner).SaveX(ctx)
+	p := client.Post.Create().
+		SetID("post-1").
+		SetInfluencer(inf).
+		SetContent("Hello").
+		SetScheduledTime(time.Now().Add(time.Hour)).
+		SaveX(ctx)
+	client.Post.Query().AllX(ctx)
+	request.End()
+
+	want := []string{"User.Create", "Influencer.Create", "Post.Create", "Post.All", "CreatePost"}
+	if got := spanNames(exporter); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("spans = %v, want %v", got, want)
+	}
+	for _, s := range exporter.GetSpans()[:4] {
+		if s.Parent.SpanID() != request.SpanContext().SpanID() {
+			t.Errorf("%s isn't nested under the request", s.Name)
+		}
+	}
+
+	// The post links to the request that scheduled it, not to the span of
+	// its own mutation
+	if got, want := p.TraceContext["traceparent"], Inject(ctx)["traceparent"]; got != want {
+		t.Errorf("trace context = %q, want %q", got, want)
+	}
+
+	// Rescheduling outside of a trace drops the link; other changes keep it
+	p = client.Post.UpdateOne(p).SetContent("Hello again").SaveX(ctx)
+	if p.TraceContext == nil {
+		t.Error("changing the content cleared the trace context")
+	}
+	p = client.Post.UpdateOne(p).SetScheduledTime(time.Now().Add(2 * time.Hour)).SaveX(context.Background())
+	if p.TraceContext != nil {
+		t.Errorf("trace context after rescheduling without a trace = %v, want none", p.TraceContext)
+	}
+}
+
+func TestSetupUnknownExporter(t *testing.T) {
+	if _, err := Setup(context.Background(), &Config{Exporter: "zipkin", SampleRatio: 1}); err == nil {
+		t.Error("Setup accepted an unknown exporter")
+	}
+}
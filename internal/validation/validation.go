@@ -0,0 +1,139 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+// UnaryInterceptor rejects requests that break the rules declared on their
+// fields with the ocs.v1.rules option
+func UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if msg, ok := req.(proto.Message); ok {
+		if err := Validate(msg); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor validates every message received on a stream
+func StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		return Validate(msg)
+	}
+	return nil
+}
+
+// Validate checks msg against its field rules. It returns an
+// INVALID_ARGUMENT status with a google.rpc.BadRequest detail listing each
+// violation, or nil if there are none.
+func Validate(msg proto.Message) error {
+	violations := check(msg.ProtoReflect(), "", time.Now())
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(violations))
+	for i, v := range violations {
+		descriptions[i] = v.Field + " " + v.Description
+	}
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(descriptions, "; "))
+	st, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return st.Err()
+}
+
+// check returns the violations of m and of the messages nested in it, with
+// field paths starting at prefix
+func check(m protoreflect.Message, prefix string, now time.Time) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+		violate := func(format string, args ...any) {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       path,
+				Description: fmt.Sprintf(format, args...),
+			})
+		}
+
+		rules, _ := proto.GetExtension(fd.Options(), ocsv1.E_Rules).(*ocsv1.FieldRules)
+		if !m.Has(fd) {
+			if rules.GetRequired() {
+				violate("is required")
+			}
+			continue
+		}
+
+		switch {
+		case fd.IsList():
+			list := m.Get(fd).List()
+			if max := rules.GetMaxItems(); max > 0 && uint32(list.Len()) > max {
+				violate("must have at most %d items", max)
+			}
+			for j := 0; fd.Kind() == protoreflect.StringKind && j < list.Len(); j++ {
+				if msg := checkString(list.Get(j).String(), rules); msg != "" {
+					violations = append(violations, &errdetails.BadRequest_FieldViolation{
+						Field:       fmt.Sprintf("%s[%d]", path, j),
+						Description: msg,
+					})
+				}
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			if msg := checkString(m.Get(fd).String(), rules); msg != "" {
+				violate("%s", msg)
+			}
+		case fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() == "google.protobuf.Timestamp":
+			ts, _ := m.Get(fd).Message().Interface().(*timestamppb.Timestamp)
+			if rules.GetFuture() && ts != nil && !ts.AsTime().After(now) {
+				violate("must be in the future")
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsMap():
+			violations = append(violations, check(m.Get(fd).Message(), path+".", now)...)
+		}
+	}
+	return violations
+}
+
+// checkString describes how s breaks the rules, or returns ""
+func checkString(s string, rules *ocsv1.FieldRules) string {
+	n := uint32(utf8.RuneCountInString(s))
+	switch {
+	case rules.GetMinLen() > 0 && n < rules.GetMinLen():
+		return fmt.Sprintf("must be at least %d characters", rules.GetMinLen())
+	case rules.GetMaxLen() > 0 && n > rules.GetMaxLen():
+		return fmt.Sprintf("must be at most %d characters", rules.GetMaxLen())
+	case len(rules.GetIn()) > 0 && !slices.Contains(rules.GetIn(), s):
+		return fmt.Sprintf("must be one of %s", strings.Join(rules.GetIn(), ", "))
+	default:
+		return ""
+	}
+}
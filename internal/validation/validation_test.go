@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ocsv1 "github.com/WuPinYi/SocialForge/proto/ocs/v1"
+)
+
+func TestValidate(t *testing.T) {
+	valid := &ocsv1.SchedulePostRequest{
+		InfluencerId:  "inf-1",
+		Content:       "Hello",
+		ScheduledTime: timestamppb.New(time.Now().Add(time.Hour)),
+	}
+	if err := Validate(valid); err != nil {
+		t.Fatalf("valid request: %v", err)
+	}
+
+	err := Validate(&ocsv1.SchedulePostRequest{
+		Content:        strings.Repeat("a", 10001),
+		ScheduledTime:  timestamppb.New(time.Now().Add(-time.Hour)),
+		IdempotencyKey: "key",
+	})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("invalid request: got %v, want INVALID_ARGUMENT", err)
+	}
+
+	want := map[string]string{
+		"influencer_id":  "is required",
+		"content":        "must be at most 10000 characters",
+		"scheduled_time": "must be in the future",
+	}
+	got := map[string]string{}
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				got[v.Field] = v.Description
+			}
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("got violations %v, want %v", got, want)
+	}
+	for field, description := range want {
+		if got[field] != description {
+			t.Errorf("%s: got %q, want %q", field, got[field], description)
+		}
+	}
+}
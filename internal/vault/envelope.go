@@ -0,0 +1,130 @@
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+)
+
+// Envelope is a secret sealed with a random data key, together with that
+// data key sealed by a key-encryption key from the keyring
+type Envelope struct {
+	Ciphertext []byte
+	WrappedKey []byte
+	KeyVersion int
+}
+
+// Seal encrypts plaintext with a fresh data key and wraps the data key with
+// the active KEK. The additional data is authenticated but not encrypted;
+// it binds the envelope to its owner so ciphertexts cannot be swapped
+// between rows.
+func (kr *Keyring) Seal(plaintext, additionalData []byte) (*Envelope, error) {
+	dek := make([]byte, 32)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %v", err)
+	}
+
+	ciphertext, err := seal(dek, plaintext, additionalData)
+	if err != nil {
+		return nil, err
+	}
+
+	kek, err := kr.key(kr.active)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := seal(kek, dek, additionalData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		Ciphertext: ciphertext,
+		WrappedKey: wrapped,
+		KeyVersion: kr.active,
+	}, nil
+}
+
+// Open decrypts an envelope sealed with the same additional data
+func (kr *Keyring) Open(env *Envelope, additionalData []byte) ([]byte, error) {
+	dek, err := kr.unwrap(env, additionalData)
+	if err != nil {
+		return nil, err
+	}
+	return open(dek, env.Ciphertext, additionalData)
+}
+
+// Rewrap re-encrypts the data key of an envelope with the active KEK. The
+// secret itself is not touched, so rotation never exposes plaintext.
+func (kr *Keyring) Rewrap(env *Envelope, additionalData []byte) (*Envelope, error) {
+	dek, err := kr.unwrap(env, additionalData)
+	if err != nil {
+		return nil, err
+	}
+
+	kek, err := kr.key(kr.active)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := seal(kek, dek, additionalData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		Ciphertext: env.Ciphertext,
+		WrappedKey: wrapped,
+		KeyVersion: kr.active,
+	}, nil
+}
+
+func (kr *Keyring) unwrap(env *Envelope, additionalData []byte) ([]byte, error) {
+	kek, err := kr.key(env.KeyVersion)
+	if err != nil {
+		return nil, err
+	}
+	dek, err := open(kek, env.WrappedKey, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %v", err)
+	}
+	return dek, nil
+}
+
+// seal encrypts with AES-256-GCM and prepends the random nonce
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %v", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open reverses seal
+func open(key, sealed, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %v", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Keyring holds the versioned key-encryption keys (KEKs). New data keys are
+// always wrapped with the active version; older versions are kept so that
+// existing credentials can still be opened until they are rewrapped.
+type Keyring struct {
+	keys   map[int][]byte
+	active int
+}
+
+// ParseKeyring parses keys in the form "version:base64key", separated by
+// commas or newlines. Every key must decode to 32 bytes (AES-256). If
+// active is 0, the highest version becomes the active one.
+func ParseKeyring(spec string, active int) (*Keyring, error) {
+	kr := &Keyring{keys: make(map[int][]byte)}
+	for _, entry := range strings.FieldsFunc(spec, func(r rune) bool { return r == ',' || r == '\n' }) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		v, k, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid key entry: expected version:base64key")
+		}
+		version, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid key version %q", v)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(k))
+		if err != nil {
+			return nil, fmt.Errorf("invalid key for version %d: %v", version, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("key version %d must be 32 bytes, got %d", version, len(key))
+		}
+		if _, dup := kr.keys[version]; dup {
+			return nil, fmt.Errorf("duplicate key version %d", version)
+		}
+		kr.keys[version] = key
+		if active == 0 && version > kr.active {
+			kr.active = version
+		}
+	}
+
+	if len(kr.keys) == 0 {
+		return nil, fmt.Errorf("keyring is empty")
+	}
+	if active != 0 {
+		if _, ok := kr.keys[active]; !ok {
+			return nil, fmt.Errorf("active key version %d is not in the keyring", active)
+		}
+		kr.active = active
+	}
+	return kr, nil
+}
+
+// ActiveVersion returns the key version used for new encryptions
+func (kr *Keyring) ActiveVersion() int {
+	return kr.active
+}
+
+func (kr *Keyring) key(version int) ([]byte, error) {
+	key, ok := kr.keys[version]
+	if !ok {
+		return nil, fmt.Errorf("key version %d is not in the keyring", version)
+	}
+	return key, nil
+}
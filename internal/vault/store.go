@@ -0,0 +1,191 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+)
+
+// ErrNoCredential is returned when an influencer has no stored credential
+var ErrNoCredential = errors.New("no credential stored for influencer")
+
+// rewrapBatchSize is the number of credentials rewrapped per query
+const rewrapBatchSize = 100
+
+// Secret is the credential material for an influencer's platform account
+type Secret struct {
+	AccessToken  string    `json:"access_token,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+	AppSecret    string    `json:"app_secret,omitempty"`
+}
+
+// Store persists influencer credentials encrypted with the keyring.
+//
+// Store deliberately has no method that returns a credential to an RPC
+// handler: Open is only called from the workers, to publish and to refresh
+// tokens.
+type Store struct {
+	client  *ent.Client
+	keyring *Keyring
+}
+
+// NewStore creates a new credential store
+func NewStore(client *ent.Client, keyring *Keyring) *Store {
+	return &Store{
+		client:  client,
+		keyring: keyring,
+	}
+}
+
+// Put encrypts secret and stores it for the influencer, replacing any
+// existing credential
+func (s *Store) Put(ctx context.Context, influencerID string, secret *Secret) error {
+	plaintext, err := json.Marshal(secret)
+	if err != nil {
+		return fmt.Errorf("failed to encode credential: %v", err)
+	}
+	env, err := s.keyring.Seal(plaintext, additionalData(influencerID))
+	if err != nil {
+		return fmt.Errorf("failed to encrypt credential: %v", err)
+	}
+
+	var expiresAt *time.Time
+	if !secret.Expiry.IsZero() {
+		expiresAt = &secret.Expiry
+	}
+
+	// Replace the credential if there is one. Otherwise create it, unless a
+	// concurrent Put for the same influencer does first, in which case this
+	// one replaces it.
+	update := func() (bool, error) {
+		update := s.client.Credential.Update().
+			Where(credential.InfluencerID(influencerID)).
+			SetCiphertext(env.Ciphertext).
+			SetWrappedKey(env.WrappedKey).
+			SetKeyVersion(env.KeyVersion)
+		if expiresAt != nil {
+			update.SetExpiresAt(*expiresAt)
+		} else {
+			update.ClearExpiresAt()
+		}
+		n, err := update.Save(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to update credential: %v", err)
+		}
+		return n > 0, nil
+	}
+	updated, err := update()
+	if err != nil || updated {
+		return err
+	}
+	err = s.client.Credential.Create().
+		SetID(uuid.New().String()).
+		SetInfluencerID(influencerID).
+		SetCiphertext(env.Ciphertext).
+		SetWrappedKey(env.WrappedKey).
+		SetKeyVersion(env.KeyVersion).
+		SetNillableExpiresAt(expiresAt).
+		Exec(ctx)
+	if !ent.IsConstraintError(err) {
+		return err
+	}
+	_, err = update()
+	return err
+}
+
+// Open decrypts the influencer's credential
+func (s *Store) Open(ctx context.Context, influencerID string) (*Secret, error) {
+	c, err := s.client.Credential.Query().
+		Where(credential.InfluencerID(influencerID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNoCredential
+		}
+		return nil, fmt.Errorf("failed to get credential: %v", err)
+	}
+
+	plaintext, err := s.keyring.Open(envelope(c), additionalData(influencerID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt credential: %v", err)
+	}
+
+	var secret Secret
+	if err := json.Unmarshal(plaintext, &secret); err != nil {
+		return nil, fmt.Errorf("failed to decode credential: %v", err)
+	}
+	return &secret, nil
+}
+
+// Delete removes the influencer's credential, if any
+func (s *Store) Delete(ctx context.Context, influencerID string) error {
+	_, err := s.client.Credential.Delete().
+		Where(credential.InfluencerID(influencerID)).
+		Exec(ctx)
+	return err
+}
+
+// RewrapAll rewraps the data key of every credential that is not yet
+// protected by the active key version, and returns how many were changed.
+// Once it reports zero, retired key versions can be removed from the keyring.
+// A credential that a concurrent Put replaces after it was read is left alone,
+// since the new one is already sealed with the active version.
+func (s *Store) RewrapAll(ctx context.Context) (int, error) {
+	active := s.keyring.ActiveVersion()
+	total := 0
+	for {
+		creds, err := s.client.Credential.Query().
+			Where(credential.KeyVersionNEQ(active)).
+			Limit(rewrapBatchSize).
+			All(ctx)
+		if err != nil {
+			return total, fmt.Errorf("failed to list credentials: %v", err)
+		}
+		if len(creds) == 0 {
+			return total, nil
+		}
+
+		for _, c := range creds {
+			env, err := s.keyring.Rewrap(envelope(c), additionalData(c.InfluencerID))
+			if err != nil {
+				return total, fmt.Errorf("failed to rewrap credential %s: %v", c.ID, err)
+			}
+			// Only write the rewrapped key over the one it was made from
+			n, err := s.client.Credential.Update().
+				Where(
+					credential.ID(c.ID),
+					credential.KeyVersion(c.KeyVersion),
+					credential.WrappedKey(c.WrappedKey),
+				).
+				SetWrappedKey(env.WrappedKey).
+				SetKeyVersion(env.KeyVersion).
+				Save(ctx)
+			if err != nil {
+				return total, fmt.Errorf("failed to update credential %s: %v", c.ID, err)
+			}
+			total += n
+		}
+	}
+}
+
+func envelope(c *ent.Credential) *Envelope {
+	return &Envelope{
+		Ciphertext: c.Ciphertext,
+		WrappedKey: c.WrappedKey,
+		KeyVersion: c.KeyVersion,
+	}
+}
+
+// additionalData binds a credential's ciphertext to its influencer
+func additionalData(influencerID string) []byte {
+	return []byte("influencer:" + influencerID)
+}
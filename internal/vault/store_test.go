@@ -0,0 +1,125 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+)
+
+func TestStorePutReplaces(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	u := client.User.Create().SetID("u1").SetName("Owner").SetAuth0ID("auth0|u1").SaveX(ctx)
+	client.Influencer.Create().
+		SetID("inf1").
+		SetName("Influencer").
+		SetPlatform("twitter").
+		SetAccountID("acct").
+		SetOwner(u).
+		SaveX(ctx)
+
+	key := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	keyring, err := ParseKeyring("1:"+key, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := NewStore(client, keyring)
+
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	if err := store.Put(ctx, "inf1", &Secret{AccessToken: "first", Expiry: expiry}); err != nil {
+		t.Fatalf("first Put: %v", err)
+	}
+	if err := store.Put(ctx, "inf1", &Secret{AccessToken: "second"}); err != nil {
+		t.Fatalf("second Put: %v", err)
+	}
+
+	if n := client.Credential.Query().Where(credential.InfluencerID("inf1")).CountX(ctx); n != 1 {
+		t.Fatalf("got %d credentials, want 1", n)
+	}
+	secret, err := store.Open(ctx, "inf1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret.AccessToken != "second" {
+		t.Errorf("AccessToken = %q, want second", secret.AccessToken)
+	}
+	c := client.Credential.Query().OnlyX(ctx)
+	if c.ExpiresAt != nil {
+		t.Errorf("ExpiresAt = %v, want cleared", c.ExpiresAt)
+	}
+}
+
+func TestRewrapAllKeepsConcurrentPut(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	ctx := context.Background()
+
+	u := client.User.Create().SetID("u1").SetName("Owner").SetAuth0ID("auth0|u1").SaveX(ctx)
+	client.Influencer.Create().
+		SetID("inf1").
+		SetName("Influencer").
+		SetPlatform("twitter").
+		SetAccountID("acct").
+		SetOwner(u).
+		SaveX(ctx)
+
+	spec := "1:" + base64.StdEncoding.EncodeToString(make([]byte, 32)) +
+		",2:" + base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	old, err := ParseKeyring(spec, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := ParseKeyring(spec, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewStore(client, old).Put(ctx, "inf1", &Secret{AccessToken: "first"}); err != nil {
+		t.Fatalf("Put with the old key: %v", err)
+	}
+	store := NewStore(client, current)
+
+	// Replace the credential after RewrapAll has read it, just before it
+	// writes the rewrapped key. Put always sets the ciphertext and a rewrap
+	// never does.
+	putDone := false
+	client.Credential.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			cm := m.(*ent.CredentialMutation)
+			if _, sealing := cm.Ciphertext(); !sealing && !putDone {
+				putDone = true
+				if err := store.Put(ctx, "inf1", &Secret{AccessToken: "second"}); err != nil {
+					return nil, fmt.Errorf("concurrent Put: %v", err)
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	n, err := store.RewrapAll(ctx)
+	if err != nil {
+		t.Fatalf("RewrapAll: %v", err)
+	}
+	if !putDone {
+		t.Fatal("RewrapAll didn't write a rewrapped key")
+	}
+	if n != 0 {
+		t.Errorf("RewrapAll changed %d credentials, want 0", n)
+	}
+	secret, err := store.Open(ctx, "inf1")
+	if err != nil {
+		t.Fatalf("Open after the concurrent Put: %v", err)
+	}
+	if secret.AccessToken != "second" {
+		t.Errorf("AccessToken = %q, want second", secret.AccessToken)
+	}
+}
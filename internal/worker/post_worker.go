@@ -2,25 +2,72 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"log"
+	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/WuPinYi/SocialForge/internal/ent"
 	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/metrics"
+	"github.com/WuPinYi/SocialForge/internal/postevents"
+	"github.com/WuPinYi/SocialForge/internal/tracing"
+	"github.com/WuPinYi/SocialForge/internal/vault"
 )
 
+// PostInterval is how often the PostWorker looks for due posts
+const PostInterval = 1 * time.Minute
+
 type PostWorker struct {
-	client *ent.Client
+	client      *ent.Client
+	credentials *vault.Store
+	events      *postevents.Broker
+
+	// lastSuccess is the UnixNano time the worker started or last
+	// processed the due posts without error
+	lastSuccess atomic.Int64
+
+	// failed holds the IDs of the posts whose last publishing attempt
+	// failed, so that the next one is counted as a retry. Only the worker
+	// goroutine uses it.
+	failed map[string]bool
 }
 
-func NewPostWorker(client *ent.Client) *PostWorker {
-	return &PostWorker{
+// Option configures optional behaviour of the PostWorker
+type Option func(*PostWorker)
+
+// WithCredentialStore lets the worker decrypt influencer credentials when publishing
+func WithCredentialStore(store *vault.Store) Option {
+	return func(w *PostWorker) {
+		w.credentials = store
+	}
+}
+
+// WithPostEvents reports failed publishing attempts to watchers. Successful
+// ones are published by the broker's hook when the status changes.
+func WithPostEvents(broker *postevents.Broker) Option {
+	return func(w *PostWorker) {
+		w.events = broker
+	}
+}
+
+func NewPostWorker(client *ent.Client, opts ...Option) *PostWorker {
+	w := &PostWorker{
 		client: client,
+		failed: make(map[string]bool),
 	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
 }
 
 func (w *PostWorker) Start(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Minute)
+	w.lastSuccess.Store(time.Now().UnixNano())
+	ticker := time.NewTicker(PostInterval)
 	defer ticker.Stop()
 
 	for {
@@ -28,13 +75,30 @@ func (w *PostWorker) Start(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			if err := w.processScheduledPosts(ctx); err != nil {
+			start := time.Now()
+			tickCtx, span := tracing.StartSpan(ctx, "ProcessScheduledPosts")
+			err := w.processScheduledPosts(tickCtx)
+			tracing.End(span, err)
+			metrics.ObserveWorkerTick(time.Since(start), err)
+			if err != nil {
 				log.Printf("Error processing scheduled posts: %v", err)
+				continue
 			}
+			w.lastSuccess.Store(time.Now().UnixNano())
 		}
 	}
 }
 
+// LastSuccess returns when the worker last processed the due posts without
+// error, or when it started if it hasn't yet. It is zero before Start.
+func (w *PostWorker) LastSuccess() time.Time {
+	ns := w.lastSuccess.Load()
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
+
 func (w *PostWorker) processScheduledPosts(ctx context.Context) error {
 	// Find all posts that are scheduled and due
 	posts, err := w.client.Post.Query().
@@ -46,32 +110,85 @@ func (w *PostWorker) processScheduledPosts(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	metrics.SetDuePosts(len(posts))
+
+	// Only the posts that fail again are remembered, which drops those
+	// that were posted, rescheduled or deleted
+	failed := w.failed
+	w.failed = make(map[string]bool)
 
 	for _, p := range posts {
-		// Get the influencer for this post
-		influencer, err := p.QueryInfluencer().Only(ctx)
-		if err != nil {
-			log.Printf("Error getting influencer for post %s: %v", p.ID, err)
-			continue
-		}
+		w.processPost(ctx, p, failed[p.ID])
+	}
+
+	return nil
+}
+
+// processPost publishes a due post and marks it as posted. Its span is
+// linked to the request that scheduled the post. retry is set if the
+// previous attempt failed.
+func (w *PostWorker) processPost(ctx context.Context, p *ent.Post, retry bool) {
+	ctx, span := tracing.StartSpan(ctx, "PublishPost",
+		tracing.LinkTo(p.TraceContext),
+		trace.WithAttributes(
+			attribute.String("post.id", p.ID),
+			attribute.String("influencer.id", p.InfluencerID),
+			attribute.Bool("publish.retry", retry),
+		),
+	)
+	var err error
+	defer func() { tracing.End(span, err) }()
+
+	// Get the influencer for this post
+	influencer, err := p.QueryInfluencer().Only(ctx)
+	if err != nil {
+		log.Printf("Error getting influencer for post %s: %v", p.ID, err)
+		return
+	}
+	span.SetAttributes(attribute.String("influencer.platform", influencer.Platform))
 
-		// TODO: Implement actual posting logic here
-		// This would involve:
-		// 1. Getting the appropriate social media client
-		// 2. Posting the content
-		// 3. Updating the post status
-
-		// For now, we'll just update the status
-		_, err = p.Update().
-			SetStatus("posted").
-			Save(ctx)
-		if err != nil {
-			log.Printf("Error updating post status %s: %v", p.ID, err)
-			continue
+	if err = w.publish(ctx, influencer, p); err != nil {
+		metrics.ObservePublish(influencer.Platform, metrics.OutcomeFailed, retry, 0)
+		w.failed[p.ID] = true
+		log.Printf("Error publishing post %s: %v", p.ID, err)
+		if w.events != nil {
+			w.events.Publish(postevents.Event{Kind: postevents.KindFailed, Post: p, Error: err.Error()})
 		}
+		return
+	}
+
+	// For now, we'll just update the status
+	_, err = p.Update().
+		SetStatus("posted").
+		Save(ctx)
+	if err != nil {
+		log.Printf("Error updating post status %s: %v", p.ID, err)
+		return
+	}
+	metrics.ObservePublish(influencer.Platform, metrics.OutcomePosted, retry, time.Since(p.ScheduledTime))
 
-		log.Printf("Successfully processed post %s for influencer %s", p.ID, influencer.Name)
+	log.Printf("Successfully processed post %s for influencer %s", p.ID, influencer.Name)
+}
+
+// publish sends a post to the influencer's platform. Apart from the token
+// refresher, this is the only place where platform credentials are
+// decrypted; they are dropped as soon as the post has been handed to the
+// platform.
+func (w *PostWorker) publish(ctx context.Context, influencer *ent.Influencer, p *ent.Post) error {
+	var secret *vault.Secret
+	if w.credentials != nil {
+		var err error
+		secret, err = w.credentials.Open(ctx, influencer.ID)
+		if err != nil && !errors.Is(err, vault.ErrNoCredential) {
+			return err
+		}
 	}
 
+	// TODO: Implement actual posting logic here
+	// This would involve:
+	// 1. Getting the appropriate social media client
+	// 2. Posting the content with the decrypted credentials
+	_ = secret
+
 	return nil
 }
@@ -0,0 +1,166 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/WuPinYi/SocialForge/internal/connect"
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/vault"
+)
+
+// credentialStore opens and stores the influencers' credentials. It is
+// implemented by *vault.Store.
+type credentialStore interface {
+	Open(ctx context.Context, influencerID string) (*vault.Secret, error)
+	Put(ctx context.Context, influencerID string, secret *vault.Secret) error
+}
+
+// TokenRefresher refreshes platform tokens before they expire. When a
+// platform rejects the refresh, the influencer is marked disconnected, its
+// scheduled posts are paused and its owner is notified.
+type TokenRefresher struct {
+	client      *ent.Client
+	credentials credentialStore
+	config      *connect.Config
+	httpClient  *http.Client
+}
+
+func NewTokenRefresher(client *ent.Client, credentials *vault.Store, config *connect.Config) *TokenRefresher {
+	return &TokenRefresher{
+		client:      client,
+		credentials: credentials,
+		config:      config,
+		httpClient:  http.DefaultClient,
+	}
+}
+
+func (r *TokenRefresher) Start(ctx context.Context) {
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.refreshExpiring(ctx); err != nil {
+				log.Printf("Error refreshing tokens: %v", err)
+			}
+		}
+	}
+}
+
+func (r *TokenRefresher) refreshExpiring(ctx context.Context) error {
+	// Find the credentials of connected influencers that expire soon. The
+	// predicate doesn't pass through the soft-delete interceptor, so it
+	// leaves out deleted influencers itself.
+	creds, err := r.client.Credential.Query().
+		Where(
+			credential.ExpiresAtLT(time.Now().Add(connect.RefreshWindow)),
+			credential.HasInfluencerWith(
+				influencer.StatusNEQ("disconnected"),
+				influencer.DeletedAtIsNil(),
+			),
+		).
+		WithInfluencer(func(q *ent.InfluencerQuery) {
+			q.WithOwner()
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range creds {
+		// The influencer may have been deleted since the query
+		if c.Edges.Influencer == nil {
+			continue
+		}
+		if err := r.refresh(ctx, c.Edges.Influencer); err != nil {
+			log.Printf("Error refreshing token for influencer %s: %v", c.InfluencerID, err)
+		}
+	}
+	return nil
+}
+
+// refresh renews the influencer's token. Transient failures are left for
+// the next tick; a rejected refresh disconnects the influencer.
+func (r *TokenRefresher) refresh(ctx context.Context, inf *ent.Influencer) error {
+	secret, err := r.credentials.Open(ctx, inf.ID)
+	if err != nil {
+		return err
+	}
+
+	refreshed, err := r.config.Refresh(ctx, r.httpClient, inf.Platform, secret)
+	switch {
+	case err == nil:
+		return r.credentials.Put(ctx, inf.ID, refreshed)
+	case errors.Is(err, connect.ErrRefreshRejected):
+		return r.disconnect(ctx, inf, err.Error())
+	default:
+		return err
+	}
+}
+
+// disconnect marks the influencer as disconnected, pauses its scheduled
+// posts and notifies its owner
+func (r *TokenRefresher) disconnect(ctx context.Context, inf *ent.Influencer, reason string) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.Influencer.UpdateOneID(inf.ID).
+		SetStatus("disconnected").
+		SetDisconnectReason(reason).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update influencer: %v", err)
+	}
+
+	paused, err := tx.Post.Update().
+		Where(
+			post.InfluencerID(inf.ID),
+			post.Status("scheduled"),
+		).
+		SetStatus("paused").
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to pause posts: %v", err)
+	}
+
+	// Notification IDs are time-ordered so they list chronologically
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate notification ID: %v", err)
+	}
+	err = tx.Notification.Create().
+		SetID(id.String()).
+		SetUserID(inf.Edges.Owner.ID).
+		SetKind("influencer_disconnected").
+		SetInfluencerID(inf.ID).
+		SetMessage(fmt.Sprintf(
+			"%s on %s was disconnected (%s) and %d scheduled posts were paused. Reconnect the account to resume publishing.",
+			inf.Name, inf.Platform, reason, paused)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to disconnect influencer: %v", err)
+	}
+
+	log.Printf("Disconnected influencer %s: %s", inf.ID, reason)
+	return nil
+}
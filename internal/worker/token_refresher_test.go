@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/WuPinYi/SocialForge/internal/connect"
+	"github.com/WuPinYi/SocialForge/internal/ent/enttest"
+	"github.com/WuPinYi/SocialForge/internal/softdelete"
+	"github.com/WuPinYi/SocialForge/internal/vault"
+)
+
+func TestRefreshExpiringSkipsDeletedInfluencers(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	client.Use(softdelete.Hook())
+	client.Intercept(softdelete.Interceptor())
+	ctx := context.Background()
+
+	owner := client.User.Create().SetID("user-1").SetName("Alice").SetAuth0ID("auth0|alice").SaveX(ctx)
+	inf := client.Influencer.Create().
+		SetID("inf-1").
+		SetName("Alice").
+		SetPlatform("mock").
+		SetAccountID("acct-1").
+		SetOwner(owner).
+		SaveX(ctx)
+	client.Credential.Create().
+		SetID("cred-1").
+		SetInfluencer(inf).
+		SetCiphertext([]byte("sealed")).
+		SetWrappedKey([]byte("sealed")).
+		SetKeyVersion(1).
+		SetExpiresAt(time.Now().Add(time.Minute)).
+		ExecX(ctx)
+	client.Influencer.DeleteOneID(inf.ID).ExecX(ctx)
+
+	store := &recordingStore{}
+	r := NewTokenRefresher(client, nil, &connect.Config{})
+	r.credentials = store
+	if err := r.refreshExpiring(ctx); err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if len(store.calls) > 0 {
+		t.Errorf("credential store was called: %v", store.calls)
+	}
+}
+
+// recordingStore records the calls to it and holds no credentials
+type recordingStore struct {
+	calls []string
+}
+
+func (s *recordingStore) Open(ctx context.Context, influencerID string) (*vault.Secret, error) {
+	s.calls = append(s.calls, "Open "+influencerID)
+	return nil, vault.ErrNoCredential
+}
+
+func (s *recordingStore) Put(ctx context.Context, influencerID string, secret *vault.Secret) error {
+	s.calls = append(s.calls, "Put "+influencerID)
+	return nil
+}
@@ -0,0 +1,100 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/WuPinYi/SocialForge/internal/ent"
+	"github.com/WuPinYi/SocialForge/internal/ent/accessgrant"
+	"github.com/WuPinYi/SocialForge/internal/ent/credential"
+	"github.com/WuPinYi/SocialForge/internal/ent/influencer"
+	"github.com/WuPinYi/SocialForge/internal/ent/post"
+	"github.com/WuPinYi/SocialForge/internal/softdelete"
+)
+
+// DefaultTrashRetention is how long deleted influencers and posts are kept
+// in the trash unless configured otherwise
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// TrashPurger deletes influencers and posts for good once they have been in
+// the trash longer than the retention. An influencer takes its posts,
+// access grants and credential with it.
+type TrashPurger struct {
+	client    *ent.Client
+	retention time.Duration
+}
+
+func NewTrashPurger(client *ent.Client, retention time.Duration) *TrashPurger {
+	return &TrashPurger{
+		client:    client,
+		retention: retention,
+	}
+}
+
+func (p *TrashPurger) Start(ctx context.Context) {
+	ticker := time.NewTicker(1 * time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.Purge(ctx); err != nil {
+				log.Printf("Error purging the trash: %v", err)
+			}
+		}
+	}
+}
+
+// Purge deletes what has been in the trash longer than the retention
+func (p *TrashPurger) Purge(ctx context.Context) error {
+	// Deletes in this context remove rows instead of trashing them again
+	ctx = softdelete.Skip(ctx)
+	cutoff := time.Now().Add(-p.retention)
+
+	tx, err := p.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Post.Delete().
+		Where(post.DeletedAtLT(cutoff)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to purge posts: %v", err)
+	}
+
+	ids, err := tx.Influencer.Query().
+		Where(influencer.DeletedAtLT(cutoff)).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find influencers: %v", err)
+	}
+	if len(ids) > 0 {
+		if _, err := tx.Post.Delete().
+			Where(post.InfluencerIDIn(ids...)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to purge posts: %v", err)
+		}
+		if _, err := tx.AccessGrant.Delete().
+			Where(accessgrant.InfluencerIDIn(ids...)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to purge access grants: %v", err)
+		}
+		if _, err := tx.Credential.Delete().
+			Where(credential.InfluencerIDIn(ids...)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to purge credentials: %v", err)
+		}
+		if _, err := tx.Influencer.Delete().
+			Where(influencer.IDIn(ids...)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to purge influencers: %v", err)
+		}
+	}
+
+	return tx.Commit()
+}
@@ -19,35 +19,149 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OpinionControlService_GetUser_FullMethodName          = "/ocs.v1.OpinionControlService/GetUser"
-	OpinionControlService_ListUsers_FullMethodName        = "/ocs.v1.OpinionControlService/ListUsers"
-	OpinionControlService_UpdateUser_FullMethodName       = "/ocs.v1.OpinionControlService/UpdateUser"
-	OpinionControlService_CreateInfluencer_FullMethodName = "/ocs.v1.OpinionControlService/CreateInfluencer"
-	OpinionControlService_GetInfluencer_FullMethodName    = "/ocs.v1.OpinionControlService/GetInfluencer"
-	OpinionControlService_ListInfluencers_FullMethodName  = "/ocs.v1.OpinionControlService/ListInfluencers"
-	OpinionControlService_SchedulePost_FullMethodName     = "/ocs.v1.OpinionControlService/SchedulePost"
-	OpinionControlService_GetPost_FullMethodName          = "/ocs.v1.OpinionControlService/GetPost"
-	OpinionControlService_ListPosts_FullMethodName        = "/ocs.v1.OpinionControlService/ListPosts"
+	OpinionControlService_GetUser_FullMethodName                     = "/ocs.v1.OpinionControlService/GetUser"
+	OpinionControlService_ListUsers_FullMethodName                   = "/ocs.v1.OpinionControlService/ListUsers"
+	OpinionControlService_UpdateUser_FullMethodName                  = "/ocs.v1.OpinionControlService/UpdateUser"
+	OpinionControlService_CreateInfluencer_FullMethodName            = "/ocs.v1.OpinionControlService/CreateInfluencer"
+	OpinionControlService_GetInfluencer_FullMethodName               = "/ocs.v1.OpinionControlService/GetInfluencer"
+	OpinionControlService_UpdateInfluencer_FullMethodName            = "/ocs.v1.OpinionControlService/UpdateInfluencer"
+	OpinionControlService_ListInfluencers_FullMethodName             = "/ocs.v1.OpinionControlService/ListInfluencers"
+	OpinionControlService_GrantInfluencerAccess_FullMethodName       = "/ocs.v1.OpinionControlService/GrantInfluencerAccess"
+	OpinionControlService_RevokeInfluencerAccess_FullMethodName      = "/ocs.v1.OpinionControlService/RevokeInfluencerAccess"
+	OpinionControlService_ListInfluencerAccessGrants_FullMethodName  = "/ocs.v1.OpinionControlService/ListInfluencerAccessGrants"
+	OpinionControlService_DeleteInfluencer_FullMethodName            = "/ocs.v1.OpinionControlService/DeleteInfluencer"
+	OpinionControlService_RestoreInfluencer_FullMethodName           = "/ocs.v1.OpinionControlService/RestoreInfluencer"
+	OpinionControlService_SetInfluencerCredentials_FullMethodName    = "/ocs.v1.OpinionControlService/SetInfluencerCredentials"
+	OpinionControlService_DeleteInfluencerCredentials_FullMethodName = "/ocs.v1.OpinionControlService/DeleteInfluencerCredentials"
+	OpinionControlService_ConnectInfluencer_FullMethodName           = "/ocs.v1.OpinionControlService/ConnectInfluencer"
+	OpinionControlService_CompleteConnectInfluencer_FullMethodName   = "/ocs.v1.OpinionControlService/CompleteConnectInfluencer"
+	OpinionControlService_SchedulePost_FullMethodName                = "/ocs.v1.OpinionControlService/SchedulePost"
+	OpinionControlService_GetPost_FullMethodName                     = "/ocs.v1.OpinionControlService/GetPost"
+	OpinionControlService_UpdatePost_FullMethodName                  = "/ocs.v1.OpinionControlService/UpdatePost"
+	OpinionControlService_ApprovePost_FullMethodName                 = "/ocs.v1.OpinionControlService/ApprovePost"
+	OpinionControlService_DeletePost_FullMethodName                  = "/ocs.v1.OpinionControlService/DeletePost"
+	OpinionControlService_RestorePost_FullMethodName                 = "/ocs.v1.OpinionControlService/RestorePost"
+	OpinionControlService_ListTrash_FullMethodName                   = "/ocs.v1.OpinionControlService/ListTrash"
+	OpinionControlService_ListPosts_FullMethodName                   = "/ocs.v1.OpinionControlService/ListPosts"
+	OpinionControlService_SearchPosts_FullMethodName                 = "/ocs.v1.OpinionControlService/SearchPosts"
+	OpinionControlService_WatchPosts_FullMethodName                  = "/ocs.v1.OpinionControlService/WatchPosts"
+	OpinionControlService_ListCalendar_FullMethodName                = "/ocs.v1.OpinionControlService/ListCalendar"
+	OpinionControlService_CreateOrganization_FullMethodName          = "/ocs.v1.OpinionControlService/CreateOrganization"
+	OpinionControlService_ListOrganizations_FullMethodName           = "/ocs.v1.OpinionControlService/ListOrganizations"
+	OpinionControlService_AddMember_FullMethodName                   = "/ocs.v1.OpinionControlService/AddMember"
+	OpinionControlService_UpdateMember_FullMethodName                = "/ocs.v1.OpinionControlService/UpdateMember"
+	OpinionControlService_RemoveMember_FullMethodName                = "/ocs.v1.OpinionControlService/RemoveMember"
+	OpinionControlService_ListMembers_FullMethodName                 = "/ocs.v1.OpinionControlService/ListMembers"
+	OpinionControlService_CreateServiceAccount_FullMethodName        = "/ocs.v1.OpinionControlService/CreateServiceAccount"
+	OpinionControlService_ListServiceAccounts_FullMethodName         = "/ocs.v1.OpinionControlService/ListServiceAccounts"
+	OpinionControlService_RotateServiceAccountKey_FullMethodName     = "/ocs.v1.OpinionControlService/RotateServiceAccountKey"
+	OpinionControlService_RevokeServiceAccountKey_FullMethodName     = "/ocs.v1.OpinionControlService/RevokeServiceAccountKey"
+	OpinionControlService_ListAuditEvents_FullMethodName             = "/ocs.v1.OpinionControlService/ListAuditEvents"
+	OpinionControlService_ExportAuditEvents_FullMethodName           = "/ocs.v1.OpinionControlService/ExportAuditEvents"
+	OpinionControlService_ListNotifications_FullMethodName           = "/ocs.v1.OpinionControlService/ListNotifications"
+	OpinionControlService_MarkNotificationRead_FullMethodName        = "/ocs.v1.OpinionControlService/MarkNotificationRead"
 )
 
 // OpinionControlServiceClient is the client API for OpinionControlService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// OpinionControlService provides methods for managing influencers and posts
+// OpinionControlService provides methods for managing influencers and posts.
+//
+// Every RPC is also served as JSON over HTTP by the gateway, at the path in
+// its google.api.http option. List RPCs take page_size and page_token as
+// query parameters.
+//
+// List RPCs return up to page_size rows, 100 by default and at most 1000,
+// in a stable order. next_page_token is an opaque token for the next page
+// and is empty on the last page. It is only valid with the same request
+// parameters, apart from page_size.
 type OpinionControlServiceClient interface {
-	// User Management
+	// GetUser returns a user; use "me" for the caller
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// ListUsers lists all users
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// UpdateUser changes a user's name or role
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
-	// Influencer Management
+	// CreateInfluencer adds an influencer to the active organization
 	CreateInfluencer(ctx context.Context, in *CreateInfluencerRequest, opts ...grpc.CallOption) (*CreateInfluencerResponse, error)
+	// GetInfluencer returns an influencer the caller has access to
 	GetInfluencer(ctx context.Context, in *GetInfluencerRequest, opts ...grpc.CallOption) (*GetInfluencerResponse, error)
+	// UpdateInfluencer changes an influencer's name or platform
+	UpdateInfluencer(ctx context.Context, in *UpdateInfluencerRequest, opts ...grpc.CallOption) (*UpdateInfluencerResponse, error)
+	// ListInfluencers lists the influencers of the active organization
 	ListInfluencers(ctx context.Context, in *ListInfluencersRequest, opts ...grpc.CallOption) (*ListInfluencersResponse, error)
-	// Post Management
+	// GrantInfluencerAccess gives a user delegated access to an influencer
+	GrantInfluencerAccess(ctx context.Context, in *GrantInfluencerAccessRequest, opts ...grpc.CallOption) (*GrantInfluencerAccessResponse, error)
+	// RevokeInfluencerAccess removes a user's access grant
+	RevokeInfluencerAccess(ctx context.Context, in *RevokeInfluencerAccessRequest, opts ...grpc.CallOption) (*RevokeInfluencerAccessResponse, error)
+	// ListInfluencerAccessGrants lists the access grants of an influencer
+	ListInfluencerAccessGrants(ctx context.Context, in *ListInfluencerAccessGrantsRequest, opts ...grpc.CallOption) (*ListInfluencerAccessGrantsResponse, error)
+	// DeleteInfluencer moves an influencer and its posts to the trash
+	DeleteInfluencer(ctx context.Context, in *DeleteInfluencerRequest, opts ...grpc.CallOption) (*DeleteInfluencerResponse, error)
+	// RestoreInfluencer brings an influencer back from the trash
+	RestoreInfluencer(ctx context.Context, in *RestoreInfluencerRequest, opts ...grpc.CallOption) (*RestoreInfluencerResponse, error)
+	// SetInfluencerCredentials stores an influencer's platform credentials
+	SetInfluencerCredentials(ctx context.Context, in *SetInfluencerCredentialsRequest, opts ...grpc.CallOption) (*SetInfluencerCredentialsResponse, error)
+	// DeleteInfluencerCredentials removes an influencer's platform credentials
+	DeleteInfluencerCredentials(ctx context.Context, in *DeleteInfluencerCredentialsRequest, opts ...grpc.CallOption) (*DeleteInfluencerCredentialsResponse, error)
+	// ConnectInfluencer starts connecting a platform account through OAuth
+	ConnectInfluencer(ctx context.Context, in *ConnectInfluencerRequest, opts ...grpc.CallOption) (*ConnectInfluencerResponse, error)
+	// CompleteConnectInfluencer completes a connect flow started with a
+	// redirect_url
+	CompleteConnectInfluencer(ctx context.Context, in *CompleteConnectInfluencerRequest, opts ...grpc.CallOption) (*CompleteConnectInfluencerResponse, error)
+	// SchedulePost schedules a post for publishing
 	SchedulePost(ctx context.Context, in *SchedulePostRequest, opts ...grpc.CallOption) (*SchedulePostResponse, error)
+	// GetPost returns a post
 	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error)
+	// UpdatePost changes the content or schedule of a post
+	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)
+	// ApprovePost approves a draft for publishing
+	ApprovePost(ctx context.Context, in *ApprovePostRequest, opts ...grpc.CallOption) (*ApprovePostResponse, error)
+	// DeletePost moves a post to the trash
+	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	// RestorePost brings a post back from the trash
+	RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error)
+	// ListTrash lists deleted influencers or posts until they are purged
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// ListPosts lists the posts of an influencer
 	ListPosts(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
+	// SearchPosts finds the posts of all visible influencers by their
+	// content or influencer name
+	SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error)
+	// WatchPosts streams changes to posts as they happen
+	WatchPosts(ctx context.Context, in *WatchPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPostsResponse], error)
+	// ListCalendar lists or counts the posts of all visible influencers within
+	// a time range
+	ListCalendar(ctx context.Context, in *ListCalendarRequest, opts ...grpc.CallOption) (*ListCalendarResponse, error)
+	// CreateOrganization creates an organization owned by the caller
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	// ListOrganizations lists the organizations the caller is a member of
+	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	// AddMember adds a user to the active organization
+	AddMember(ctx context.Context, in *AddMemberRequest, opts ...grpc.CallOption) (*AddMemberResponse, error)
+	// UpdateMember changes a member's role
+	UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*UpdateMemberResponse, error)
+	// RemoveMember removes a user from the active organization
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	// ListMembers lists the members of the active organization
+	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	// CreateServiceAccount creates a service account and its first API key
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
+	// ListServiceAccounts lists the caller's service accounts
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	// RotateServiceAccountKey replaces the API key of a service account
+	RotateServiceAccountKey(ctx context.Context, in *RotateServiceAccountKeyRequest, opts ...grpc.CallOption) (*RotateServiceAccountKeyResponse, error)
+	// RevokeServiceAccountKey revokes the API key of a service account
+	RevokeServiceAccountKey(ctx context.Context, in *RevokeServiceAccountKeyRequest, opts ...grpc.CallOption) (*RevokeServiceAccountKeyResponse, error)
+	// ListAuditEvents lists audit events, newest first
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// ExportAuditEvents streams all matching audit events as JSON Lines
+	ExportAuditEvents(ctx context.Context, in *ExportAuditEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAuditEventsResponse], error)
+	// ListNotifications lists the caller's notifications
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	// MarkNotificationRead marks a notification as read
+	MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*MarkNotificationReadResponse, error)
 }
 
 type opinionControlServiceClient struct {
@@ -108,280 +222,1358 @@ func (c *opinionControlServiceClient) GetInfluencer(ctx context.Context, in *Get
 	return out, nil
 }
 
-func (c *opinionControlServiceClient) ListInfluencers(ctx context.Context, in *ListInfluencersRequest, opts ...grpc.CallOption) (*ListInfluencersResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListInfluencersResponse)
-	err := c.cc.Invoke(ctx, OpinionControlService_ListInfluencers_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
+func (c *opinionControlServiceClient) UpdateInfluencer(ctx context.Context, in *UpdateInfluencerRequest, opts ...grpc.CallOption) (*UpdateInfluencerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInfluencerResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_UpdateInfluencer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ListInfluencers(ctx context.Context, in *ListInfluencersRequest, opts ...grpc.CallOption) (*ListInfluencersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInfluencersResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ListInfluencers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) GrantInfluencerAccess(ctx context.Context, in *GrantInfluencerAccessRequest, opts ...grpc.CallOption) (*GrantInfluencerAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantInfluencerAccessResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_GrantInfluencerAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) RevokeInfluencerAccess(ctx context.Context, in *RevokeInfluencerAccessRequest, opts ...grpc.CallOption) (*RevokeInfluencerAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInfluencerAccessResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_RevokeInfluencerAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ListInfluencerAccessGrants(ctx context.Context, in *ListInfluencerAccessGrantsRequest, opts ...grpc.CallOption) (*ListInfluencerAccessGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInfluencerAccessGrantsResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ListInfluencerAccessGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) DeleteInfluencer(ctx context.Context, in *DeleteInfluencerRequest, opts ...grpc.CallOption) (*DeleteInfluencerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInfluencerResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_DeleteInfluencer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) RestoreInfluencer(ctx context.Context, in *RestoreInfluencerRequest, opts ...grpc.CallOption) (*RestoreInfluencerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreInfluencerResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_RestoreInfluencer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) SetInfluencerCredentials(ctx context.Context, in *SetInfluencerCredentialsRequest, opts ...grpc.CallOption) (*SetInfluencerCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetInfluencerCredentialsResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_SetInfluencerCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) DeleteInfluencerCredentials(ctx context.Context, in *DeleteInfluencerCredentialsRequest, opts ...grpc.CallOption) (*DeleteInfluencerCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInfluencerCredentialsResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_DeleteInfluencerCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ConnectInfluencer(ctx context.Context, in *ConnectInfluencerRequest, opts ...grpc.CallOption) (*ConnectInfluencerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConnectInfluencerResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ConnectInfluencer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) CompleteConnectInfluencer(ctx context.Context, in *CompleteConnectInfluencerRequest, opts ...grpc.CallOption) (*CompleteConnectInfluencerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteConnectInfluencerResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_CompleteConnectInfluencer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) SchedulePost(ctx context.Context, in *SchedulePostRequest, opts ...grpc.CallOption) (*SchedulePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePostResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_SchedulePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_GetPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePostResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_UpdatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ApprovePost(ctx context.Context, in *ApprovePostRequest, opts ...grpc.CallOption) (*ApprovePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApprovePostResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ApprovePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_DeletePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePostResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_RestorePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ListPosts(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostsResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ListPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPostsResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_SearchPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) WatchPosts(ctx context.Context, in *WatchPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPostsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OpinionControlService_ServiceDesc.Streams[0], OpinionControlService_WatchPosts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPostsRequest, WatchPostsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OpinionControlService_WatchPostsClient = grpc.ServerStreamingClient[WatchPostsResponse]
+
+func (c *opinionControlServiceClient) ListCalendar(ctx context.Context, in *ListCalendarRequest, opts ...grpc.CallOption) (*ListCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCalendarResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ListCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ListOrganizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) AddMember(ctx context.Context, in *AddMemberRequest, opts ...grpc.CallOption) (*AddMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMemberResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_AddMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*UpdateMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMemberResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_UpdateMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMembersResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ListServiceAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) RotateServiceAccountKey(ctx context.Context, in *RotateServiceAccountKeyRequest, opts ...grpc.CallOption) (*RotateServiceAccountKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateServiceAccountKeyResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_RotateServiceAccountKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) RevokeServiceAccountKey(ctx context.Context, in *RevokeServiceAccountKeyRequest, opts ...grpc.CallOption) (*RevokeServiceAccountKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeServiceAccountKeyResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_RevokeServiceAccountKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) ExportAuditEvents(ctx context.Context, in *ExportAuditEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAuditEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OpinionControlService_ServiceDesc.Streams[1], OpinionControlService_ExportAuditEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportAuditEventsRequest, ExportAuditEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OpinionControlService_ExportAuditEventsClient = grpc.ServerStreamingClient[ExportAuditEventsResponse]
+
+func (c *opinionControlServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opinionControlServiceClient) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*MarkNotificationReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkNotificationReadResponse)
+	err := c.cc.Invoke(ctx, OpinionControlService_MarkNotificationRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OpinionControlServiceServer is the server API for OpinionControlService service.
+// All implementations must embed UnimplementedOpinionControlServiceServer
+// for forward compatibility.
+//
+// OpinionControlService provides methods for managing influencers and posts.
+//
+// Every RPC is also served as JSON over HTTP by the gateway, at the path in
+// its google.api.http option. List RPCs take page_size and page_token as
+// query parameters.
+//
+// List RPCs return up to page_size rows, 100 by default and at most 1000,
+// in a stable order. next_page_token is an opaque token for the next page
+// and is empty on the last page. It is only valid with the same request
+// parameters, apart from page_size.
+type OpinionControlServiceServer interface {
+	// GetUser returns a user; use "me" for the caller
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// ListUsers lists all users
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// UpdateUser changes a user's name or role
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// CreateInfluencer adds an influencer to the active organization
+	CreateInfluencer(context.Context, *CreateInfluencerRequest) (*CreateInfluencerResponse, error)
+	// GetInfluencer returns an influencer the caller has access to
+	GetInfluencer(context.Context, *GetInfluencerRequest) (*GetInfluencerResponse, error)
+	// UpdateInfluencer changes an influencer's name or platform
+	UpdateInfluencer(context.Context, *UpdateInfluencerRequest) (*UpdateInfluencerResponse, error)
+	// ListInfluencers lists the influencers of the active organization
+	ListInfluencers(context.Context, *ListInfluencersRequest) (*ListInfluencersResponse, error)
+	// GrantInfluencerAccess gives a user delegated access to an influencer
+	GrantInfluencerAccess(context.Context, *GrantInfluencerAccessRequest) (*GrantInfluencerAccessResponse, error)
+	// RevokeInfluencerAccess removes a user's access grant
+	RevokeInfluencerAccess(context.Context, *RevokeInfluencerAccessRequest) (*RevokeInfluencerAccessResponse, error)
+	// ListInfluencerAccessGrants lists the access grants of an influencer
+	ListInfluencerAccessGrants(context.Context, *ListInfluencerAccessGrantsRequest) (*ListInfluencerAccessGrantsResponse, error)
+	// DeleteInfluencer moves an influencer and its posts to the trash
+	DeleteInfluencer(context.Context, *DeleteInfluencerRequest) (*DeleteInfluencerResponse, error)
+	// RestoreInfluencer brings an influencer back from the trash
+	RestoreInfluencer(context.Context, *RestoreInfluencerRequest) (*RestoreInfluencerResponse, error)
+	// SetInfluencerCredentials stores an influencer's platform credentials
+	SetInfluencerCredentials(context.Context, *SetInfluencerCredentialsRequest) (*SetInfluencerCredentialsResponse, error)
+	// DeleteInfluencerCredentials removes an influencer's platform credentials
+	DeleteInfluencerCredentials(context.Context, *DeleteInfluencerCredentialsRequest) (*DeleteInfluencerCredentialsResponse, error)
+	// ConnectInfluencer starts connecting a platform account through OAuth
+	ConnectInfluencer(context.Context, *ConnectInfluencerRequest) (*ConnectInfluencerResponse, error)
+	// CompleteConnectInfluencer completes a connect flow started with a
+	// redirect_url
+	CompleteConnectInfluencer(context.Context, *CompleteConnectInfluencerRequest) (*CompleteConnectInfluencerResponse, error)
+	// SchedulePost schedules a post for publishing
+	SchedulePost(context.Context, *SchedulePostRequest) (*SchedulePostResponse, error)
+	// GetPost returns a post
+	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
+	// UpdatePost changes the content or schedule of a post
+	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
+	// ApprovePost approves a draft for publishing
+	ApprovePost(context.Context, *ApprovePostRequest) (*ApprovePostResponse, error)
+	// DeletePost moves a post to the trash
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	// RestorePost brings a post back from the trash
+	RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error)
+	// ListTrash lists deleted influencers or posts until they are purged
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// ListPosts lists the posts of an influencer
+	ListPosts(context.Context, *ListPostsRequest) (*ListPostsResponse, error)
+	// SearchPosts finds the posts of all visible influencers by their
+	// content or influencer name
+	SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error)
+	// WatchPosts streams changes to posts as they happen
+	WatchPosts(*WatchPostsRequest, grpc.ServerStreamingServer[WatchPostsResponse]) error
+	// ListCalendar lists or counts the posts of all visible influencers within
+	// a time range
+	ListCalendar(context.Context, *ListCalendarRequest) (*ListCalendarResponse, error)
+	// CreateOrganization creates an organization owned by the caller
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	// ListOrganizations lists the organizations the caller is a member of
+	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	// AddMember adds a user to the active organization
+	AddMember(context.Context, *AddMemberRequest) (*AddMemberResponse, error)
+	// UpdateMember changes a member's role
+	UpdateMember(context.Context, *UpdateMemberRequest) (*UpdateMemberResponse, error)
+	// RemoveMember removes a user from the active organization
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	// ListMembers lists the members of the active organization
+	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	// CreateServiceAccount creates a service account and its first API key
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
+	// ListServiceAccounts lists the caller's service accounts
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	// RotateServiceAccountKey replaces the API key of a service account
+	RotateServiceAccountKey(context.Context, *RotateServiceAccountKeyRequest) (*RotateServiceAccountKeyResponse, error)
+	// RevokeServiceAccountKey revokes the API key of a service account
+	RevokeServiceAccountKey(context.Context, *RevokeServiceAccountKeyRequest) (*RevokeServiceAccountKeyResponse, error)
+	// ListAuditEvents lists audit events, newest first
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// ExportAuditEvents streams all matching audit events as JSON Lines
+	ExportAuditEvents(*ExportAuditEventsRequest, grpc.ServerStreamingServer[ExportAuditEventsResponse]) error
+	// ListNotifications lists the caller's notifications
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	// MarkNotificationRead marks a notification as read
+	MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error)
+	mustEmbedUnimplementedOpinionControlServiceServer()
+}
+
+// UnimplementedOpinionControlServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOpinionControlServiceServer struct{}
+
+func (UnimplementedOpinionControlServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) CreateInfluencer(context.Context, *CreateInfluencerRequest) (*CreateInfluencerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInfluencer not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) GetInfluencer(context.Context, *GetInfluencerRequest) (*GetInfluencerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfluencer not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) UpdateInfluencer(context.Context, *UpdateInfluencerRequest) (*UpdateInfluencerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInfluencer not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListInfluencers(context.Context, *ListInfluencersRequest) (*ListInfluencersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInfluencers not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) GrantInfluencerAccess(context.Context, *GrantInfluencerAccessRequest) (*GrantInfluencerAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantInfluencerAccess not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) RevokeInfluencerAccess(context.Context, *RevokeInfluencerAccessRequest) (*RevokeInfluencerAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInfluencerAccess not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListInfluencerAccessGrants(context.Context, *ListInfluencerAccessGrantsRequest) (*ListInfluencerAccessGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInfluencerAccessGrants not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) DeleteInfluencer(context.Context, *DeleteInfluencerRequest) (*DeleteInfluencerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInfluencer not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) RestoreInfluencer(context.Context, *RestoreInfluencerRequest) (*RestoreInfluencerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreInfluencer not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) SetInfluencerCredentials(context.Context, *SetInfluencerCredentialsRequest) (*SetInfluencerCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInfluencerCredentials not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) DeleteInfluencerCredentials(context.Context, *DeleteInfluencerCredentialsRequest) (*DeleteInfluencerCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInfluencerCredentials not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ConnectInfluencer(context.Context, *ConnectInfluencerRequest) (*ConnectInfluencerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectInfluencer not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) CompleteConnectInfluencer(context.Context, *CompleteConnectInfluencerRequest) (*CompleteConnectInfluencerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteConnectInfluencer not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) SchedulePost(context.Context, *SchedulePostRequest) (*SchedulePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePost not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ApprovePost(context.Context, *ApprovePostRequest) (*ApprovePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePost not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListPosts(context.Context, *ListPostsRequest) (*ListPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPosts not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) WatchPosts(*WatchPostsRequest, grpc.ServerStreamingServer[WatchPostsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPosts not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListCalendar(context.Context, *ListCalendarRequest) (*ListCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCalendar not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) AddMember(context.Context, *AddMemberRequest) (*AddMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) UpdateMember(context.Context, *UpdateMemberRequest) (*UpdateMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMember not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) RotateServiceAccountKey(context.Context, *RotateServiceAccountKeyRequest) (*RotateServiceAccountKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateServiceAccountKey not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) RevokeServiceAccountKey(context.Context, *RevokeServiceAccountKeyRequest) (*RevokeServiceAccountKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeServiceAccountKey not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ExportAuditEvents(*ExportAuditEventsRequest, grpc.ServerStreamingServer[ExportAuditEventsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAuditEvents not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationRead not implemented")
+}
+func (UnimplementedOpinionControlServiceServer) mustEmbedUnimplementedOpinionControlServiceServer() {}
+func (UnimplementedOpinionControlServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeOpinionControlServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OpinionControlServiceServer will
+// result in compilation errors.
+type UnsafeOpinionControlServiceServer interface {
+	mustEmbedUnimplementedOpinionControlServiceServer()
+}
+
+func RegisterOpinionControlServiceServer(s grpc.ServiceRegistrar, srv OpinionControlServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOpinionControlServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OpinionControlService_ServiceDesc, srv)
+}
+
+func _OpinionControlService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_CreateInfluencer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInfluencerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).CreateInfluencer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_CreateInfluencer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).CreateInfluencer(ctx, req.(*CreateInfluencerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_GetInfluencer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfluencerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).GetInfluencer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_GetInfluencer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).GetInfluencer(ctx, req.(*GetInfluencerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_UpdateInfluencer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInfluencerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).UpdateInfluencer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_UpdateInfluencer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).UpdateInfluencer(ctx, req.(*UpdateInfluencerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_ListInfluencers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInfluencersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).ListInfluencers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_ListInfluencers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).ListInfluencers(ctx, req.(*ListInfluencersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_GrantInfluencerAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantInfluencerAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).GrantInfluencerAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_GrantInfluencerAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).GrantInfluencerAccess(ctx, req.(*GrantInfluencerAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_RevokeInfluencerAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInfluencerAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).RevokeInfluencerAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_RevokeInfluencerAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).RevokeInfluencerAccess(ctx, req.(*RevokeInfluencerAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_ListInfluencerAccessGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInfluencerAccessGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).ListInfluencerAccessGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_ListInfluencerAccessGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).ListInfluencerAccessGrants(ctx, req.(*ListInfluencerAccessGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_DeleteInfluencer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInfluencerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).DeleteInfluencer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_DeleteInfluencer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).DeleteInfluencer(ctx, req.(*DeleteInfluencerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_RestoreInfluencer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreInfluencerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).RestoreInfluencer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_RestoreInfluencer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).RestoreInfluencer(ctx, req.(*RestoreInfluencerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_SetInfluencerCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInfluencerCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).SetInfluencerCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_SetInfluencerCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).SetInfluencerCredentials(ctx, req.(*SetInfluencerCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_DeleteInfluencerCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInfluencerCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).DeleteInfluencerCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_DeleteInfluencerCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).DeleteInfluencerCredentials(ctx, req.(*DeleteInfluencerCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_ConnectInfluencer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectInfluencerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).ConnectInfluencer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_ConnectInfluencer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).ConnectInfluencer(ctx, req.(*ConnectInfluencerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_CompleteConnectInfluencer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteConnectInfluencerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).CompleteConnectInfluencer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_CompleteConnectInfluencer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).CompleteConnectInfluencer(ctx, req.(*CompleteConnectInfluencerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_SchedulePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).SchedulePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_SchedulePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).SchedulePost(ctx, req.(*SchedulePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).GetPost(ctx, req.(*GetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_ApprovePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).ApprovePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_ApprovePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).ApprovePost(ctx, req.(*ApprovePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_RestorePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).RestorePost(ctx, req.(*RestorePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_ListPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).ListPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_ListPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).ListPosts(ctx, req.(*ListPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_SearchPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).SearchPosts(ctx, req.(*SearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpinionControlService_WatchPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPostsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(OpinionControlServiceServer).WatchPosts(m, &grpc.GenericServerStream[WatchPostsRequest, WatchPostsResponse]{ServerStream: stream})
 }
 
-func (c *opinionControlServiceClient) SchedulePost(ctx context.Context, in *SchedulePostRequest, opts ...grpc.CallOption) (*SchedulePostResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SchedulePostResponse)
-	err := c.cc.Invoke(ctx, OpinionControlService_SchedulePost_FullMethodName, in, out, cOpts...)
-	if err != nil {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OpinionControlService_WatchPostsServer = grpc.ServerStreamingServer[WatchPostsResponse]
+
+func _OpinionControlService_ListCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalendarRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).ListCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_ListCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).ListCalendar(ctx, req.(*ListCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *opinionControlServiceClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPostResponse)
-	err := c.cc.Invoke(ctx, OpinionControlService_GetPost_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _OpinionControlService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *opinionControlServiceClient) ListPosts(ctx context.Context, in *ListPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListPostsResponse)
-	err := c.cc.Invoke(ctx, OpinionControlService_ListPosts_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _OpinionControlService_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// OpinionControlServiceServer is the server API for OpinionControlService service.
-// All implementations must embed UnimplementedOpinionControlServiceServer
-// for forward compatibility.
-//
-// OpinionControlService provides methods for managing influencers and posts
-type OpinionControlServiceServer interface {
-	// User Management
-	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
-	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
-	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
-	// Influencer Management
-	CreateInfluencer(context.Context, *CreateInfluencerRequest) (*CreateInfluencerResponse, error)
-	GetInfluencer(context.Context, *GetInfluencerRequest) (*GetInfluencerResponse, error)
-	ListInfluencers(context.Context, *ListInfluencersRequest) (*ListInfluencersResponse, error)
-	// Post Management
-	SchedulePost(context.Context, *SchedulePostRequest) (*SchedulePostResponse, error)
-	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
-	ListPosts(context.Context, *ListPostsRequest) (*ListPostsResponse, error)
-	mustEmbedUnimplementedOpinionControlServiceServer()
-}
-
-// UnimplementedOpinionControlServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedOpinionControlServiceServer struct{}
-
-func (UnimplementedOpinionControlServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
-}
-func (UnimplementedOpinionControlServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
-}
-func (UnimplementedOpinionControlServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
-}
-func (UnimplementedOpinionControlServiceServer) CreateInfluencer(context.Context, *CreateInfluencerRequest) (*CreateInfluencerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateInfluencer not implemented")
-}
-func (UnimplementedOpinionControlServiceServer) GetInfluencer(context.Context, *GetInfluencerRequest) (*GetInfluencerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInfluencer not implemented")
-}
-func (UnimplementedOpinionControlServiceServer) ListInfluencers(context.Context, *ListInfluencersRequest) (*ListInfluencersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListInfluencers not implemented")
-}
-func (UnimplementedOpinionControlServiceServer) SchedulePost(context.Context, *SchedulePostRequest) (*SchedulePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SchedulePost not implemented")
-}
-func (UnimplementedOpinionControlServiceServer) GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
-}
-func (UnimplementedOpinionControlServiceServer) ListPosts(context.Context, *ListPostsRequest) (*ListPostsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPosts not implemented")
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_ListOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).ListOrganizations(ctx, req.(*ListOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOpinionControlServiceServer) mustEmbedUnimplementedOpinionControlServiceServer() {}
-func (UnimplementedOpinionControlServiceServer) testEmbeddedByValue()                               {}
 
-// UnsafeOpinionControlServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to OpinionControlServiceServer will
-// result in compilation errors.
-type UnsafeOpinionControlServiceServer interface {
-	mustEmbedUnimplementedOpinionControlServiceServer()
+func _OpinionControlService_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).AddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_AddMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).AddMember(ctx, req.(*AddMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterOpinionControlServiceServer(s grpc.ServiceRegistrar, srv OpinionControlServiceServer) {
-	// If the following call pancis, it indicates UnimplementedOpinionControlServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _OpinionControlService_UpdateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&OpinionControlService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(OpinionControlServiceServer).UpdateMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpinionControlService_UpdateMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpinionControlServiceServer).UpdateMember(ctx, req.(*UpdateMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _OpinionControlService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _OpinionControlService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpinionControlServiceServer).GetUser(ctx, in)
+		return srv.(OpinionControlServiceServer).RemoveMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpinionControlService_GetUser_FullMethodName,
+		FullMethod: OpinionControlService_RemoveMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpinionControlServiceServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(OpinionControlServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpinionControlService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _OpinionControlService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpinionControlServiceServer).ListUsers(ctx, in)
+		return srv.(OpinionControlServiceServer).ListMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpinionControlService_ListUsers_FullMethodName,
+		FullMethod: OpinionControlService_ListMembers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpinionControlServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+		return srv.(OpinionControlServiceServer).ListMembers(ctx, req.(*ListMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpinionControlService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _OpinionControlService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpinionControlServiceServer).UpdateUser(ctx, in)
+		return srv.(OpinionControlServiceServer).CreateServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpinionControlService_UpdateUser_FullMethodName,
+		FullMethod: OpinionControlService_CreateServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpinionControlServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(OpinionControlServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpinionControlService_CreateInfluencer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateInfluencerRequest)
+func _OpinionControlService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpinionControlServiceServer).CreateInfluencer(ctx, in)
+		return srv.(OpinionControlServiceServer).ListServiceAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpinionControlService_CreateInfluencer_FullMethodName,
+		FullMethod: OpinionControlService_ListServiceAccounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpinionControlServiceServer).CreateInfluencer(ctx, req.(*CreateInfluencerRequest))
+		return srv.(OpinionControlServiceServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpinionControlService_GetInfluencer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfluencerRequest)
+func _OpinionControlService_RotateServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateServiceAccountKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpinionControlServiceServer).GetInfluencer(ctx, in)
+		return srv.(OpinionControlServiceServer).RotateServiceAccountKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpinionControlService_GetInfluencer_FullMethodName,
+		FullMethod: OpinionControlService_RotateServiceAccountKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpinionControlServiceServer).GetInfluencer(ctx, req.(*GetInfluencerRequest))
+		return srv.(OpinionControlServiceServer).RotateServiceAccountKey(ctx, req.(*RotateServiceAccountKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpinionControlService_ListInfluencers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListInfluencersRequest)
+func _OpinionControlService_RevokeServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeServiceAccountKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpinionControlServiceServer).ListInfluencers(ctx, in)
+		return srv.(OpinionControlServiceServer).RevokeServiceAccountKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpinionControlService_ListInfluencers_FullMethodName,
+		FullMethod: OpinionControlService_RevokeServiceAccountKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpinionControlServiceServer).ListInfluencers(ctx, req.(*ListInfluencersRequest))
+		return srv.(OpinionControlServiceServer).RevokeServiceAccountKey(ctx, req.(*RevokeServiceAccountKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpinionControlService_SchedulePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SchedulePostRequest)
+func _OpinionControlService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpinionControlServiceServer).SchedulePost(ctx, in)
+		return srv.(OpinionControlServiceServer).ListAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpinionControlService_SchedulePost_FullMethodName,
+		FullMethod: OpinionControlService_ListAuditEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpinionControlServiceServer).SchedulePost(ctx, req.(*SchedulePostRequest))
+		return srv.(OpinionControlServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpinionControlService_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostRequest)
+func _OpinionControlService_ExportAuditEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAuditEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OpinionControlServiceServer).ExportAuditEvents(m, &grpc.GenericServerStream[ExportAuditEventsRequest, ExportAuditEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OpinionControlService_ExportAuditEventsServer = grpc.ServerStreamingServer[ExportAuditEventsResponse]
+
+func _OpinionControlService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpinionControlServiceServer).GetPost(ctx, in)
+		return srv.(OpinionControlServiceServer).ListNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpinionControlService_GetPost_FullMethodName,
+		FullMethod: OpinionControlService_ListNotifications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpinionControlServiceServer).GetPost(ctx, req.(*GetPostRequest))
+		return srv.(OpinionControlServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpinionControlService_ListPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPostsRequest)
+func _OpinionControlService_MarkNotificationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationReadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpinionControlServiceServer).ListPosts(ctx, in)
+		return srv.(OpinionControlServiceServer).MarkNotificationRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OpinionControlService_ListPosts_FullMethodName,
+		FullMethod: OpinionControlService_MarkNotificationRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpinionControlServiceServer).ListPosts(ctx, req.(*ListPostsRequest))
+		return srv.(OpinionControlServiceServer).MarkNotificationRead(ctx, req.(*MarkNotificationReadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -413,10 +1605,50 @@ var OpinionControlService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfluencer",
 			Handler:    _OpinionControlService_GetInfluencer_Handler,
 		},
+		{
+			MethodName: "UpdateInfluencer",
+			Handler:    _OpinionControlService_UpdateInfluencer_Handler,
+		},
 		{
 			MethodName: "ListInfluencers",
 			Handler:    _OpinionControlService_ListInfluencers_Handler,
 		},
+		{
+			MethodName: "GrantInfluencerAccess",
+			Handler:    _OpinionControlService_GrantInfluencerAccess_Handler,
+		},
+		{
+			MethodName: "RevokeInfluencerAccess",
+			Handler:    _OpinionControlService_RevokeInfluencerAccess_Handler,
+		},
+		{
+			MethodName: "ListInfluencerAccessGrants",
+			Handler:    _OpinionControlService_ListInfluencerAccessGrants_Handler,
+		},
+		{
+			MethodName: "DeleteInfluencer",
+			Handler:    _OpinionControlService_DeleteInfluencer_Handler,
+		},
+		{
+			MethodName: "RestoreInfluencer",
+			Handler:    _OpinionControlService_RestoreInfluencer_Handler,
+		},
+		{
+			MethodName: "SetInfluencerCredentials",
+			Handler:    _OpinionControlService_SetInfluencerCredentials_Handler,
+		},
+		{
+			MethodName: "DeleteInfluencerCredentials",
+			Handler:    _OpinionControlService_DeleteInfluencerCredentials_Handler,
+		},
+		{
+			MethodName: "ConnectInfluencer",
+			Handler:    _OpinionControlService_ConnectInfluencer_Handler,
+		},
+		{
+			MethodName: "CompleteConnectInfluencer",
+			Handler:    _OpinionControlService_CompleteConnectInfluencer_Handler,
+		},
 		{
 			MethodName: "SchedulePost",
 			Handler:    _OpinionControlService_SchedulePost_Handler,
@@ -425,11 +1657,102 @@ var OpinionControlService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPost",
 			Handler:    _OpinionControlService_GetPost_Handler,
 		},
+		{
+			MethodName: "UpdatePost",
+			Handler:    _OpinionControlService_UpdatePost_Handler,
+		},
+		{
+			MethodName: "ApprovePost",
+			Handler:    _OpinionControlService_ApprovePost_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _OpinionControlService_DeletePost_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _OpinionControlService_RestorePost_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _OpinionControlService_ListTrash_Handler,
+		},
 		{
 			MethodName: "ListPosts",
 			Handler:    _OpinionControlService_ListPosts_Handler,
 		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _OpinionControlService_SearchPosts_Handler,
+		},
+		{
+			MethodName: "ListCalendar",
+			Handler:    _OpinionControlService_ListCalendar_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _OpinionControlService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _OpinionControlService_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "AddMember",
+			Handler:    _OpinionControlService_AddMember_Handler,
+		},
+		{
+			MethodName: "UpdateMember",
+			Handler:    _OpinionControlService_UpdateMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _OpinionControlService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _OpinionControlService_ListMembers_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _OpinionControlService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _OpinionControlService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "RotateServiceAccountKey",
+			Handler:    _OpinionControlService_RotateServiceAccountKey_Handler,
+		},
+		{
+			MethodName: "RevokeServiceAccountKey",
+			Handler:    _OpinionControlService_RevokeServiceAccountKey_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _OpinionControlService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _OpinionControlService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationRead",
+			Handler:    _OpinionControlService_MarkNotificationRead_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPosts",
+			Handler:       _OpinionControlService_WatchPosts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportAuditEvents",
+			Handler:       _OpinionControlService_ExportAuditEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/ocs.proto",
 }
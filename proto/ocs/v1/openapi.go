@@ -0,0 +1,9 @@
+package ocsv1
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI v3 document describing the HTTP/JSON gateway. It is
+// generated from the google.api.http options in ocs.proto.
+//
+//go:embed openapi.yaml
+var OpenAPI []byte